@@ -0,0 +1,189 @@
+package acr
+
+// this package receives Azure Container Registry webhooks
+// https://learn.microsoft.com/en-us/azure/container-registry/container-registry-webhook-reference
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+)
+
+// parse errors
+var (
+	ErrEventNotSpecifiedToParse     = errors.New("no Event specified to parse")
+	ErrInvalidHTTPMethod            = errors.New("invalid HTTP Method")
+	ErrEventNotFound                = errors.New("event not defined to be parsed")
+	ErrScopeNotFound                = errors.New("repository and tag not in the configured scopes")
+	ErrParsingPayload               = errors.New("error parsing payload")
+	ErrHeaderAuthVerificationFailed = errors.New("custom header auth verification failed")
+)
+
+// Event defines an Azure Container Registry hook action
+type Event string
+
+// Azure Container Registry hook types
+const (
+	PushEvent        Event = "push"
+	DeleteEvent      Event = "delete"
+	QuarantineEvent  Event = "quarantine"
+	ChartPushEvent   Event = "chart_push"
+	ChartDeleteEvent Event = "chart_delete"
+	PingEvent        Event = "ping"
+)
+
+// Option is a configuration option for the webhook
+type Option func(*Webhook) error
+
+// Options is a namespace var for configuration options
+var Options = WebhookOptions{}
+
+// WebhookOptions is a namespace for configuration option methods
+type WebhookOptions struct{}
+
+// Header registers a custom header, as configured on the registry webhook, which every request must carry;
+// it can be given several times, e.g. Options.Header("Authorization", "Bearer <token>")
+func (WebhookOptions) Header(name, value string) Option {
+	return func(hook *Webhook) error {
+		if len(name) == 0 {
+			return errors.New("empty header name")
+		}
+		// already convert here to prevent timing attack (conversion depends on secret)
+		hash := sha512.Sum512([]byte(value))
+		hook.headers = append(hook.headers, header{name: http.CanonicalHeaderKey(name), hash: hash[:]})
+		return nil
+	}
+}
+
+// Scope restricts the accepted events to the given "repository:tag" scopes, both parts supporting
+// the same wildcards as the registry webhook scope, e.g. "hello-world:*" or "samples/*:v1"
+func (WebhookOptions) Scope(scopes ...string) Option {
+	return func(hook *Webhook) error {
+		for _, scope := range scopes {
+			if _, err := path.Match(scope, ""); err != nil {
+				return err
+			}
+		}
+		hook.scopes = append(hook.scopes, scopes...)
+		return nil
+	}
+}
+
+type header struct {
+	name string
+	hash []byte
+}
+
+// Webhook instance contains all methods needed to process events
+type Webhook struct {
+	headers []header
+	scopes  []string
+}
+
+// New creates and returns a WebHook instance
+func New(options ...Option) (*Webhook, error) {
+	hook := new(Webhook)
+	for _, opt := range options {
+		if err := opt(hook); err != nil {
+			return nil, errors.New("Error applying Option")
+		}
+	}
+	return hook, nil
+}
+
+// Parse verifies and parses the events specified and returns the payload object or an error
+func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+
+	if !hook.verifyHeaders(r) {
+		return nil, ErrHeaderAuthVerificationFailed
+	}
+
+	if len(events) == 0 {
+		return nil, ErrEventNotSpecifiedToParse
+	}
+	if r.Method != http.MethodPost {
+		return nil, ErrInvalidHTTPMethod
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, ErrParsingPayload
+	}
+
+	var pl BasicEvent
+	err = json.Unmarshal([]byte(payload), &pl)
+	if err != nil {
+		return nil, ErrParsingPayload
+	}
+
+	var found bool
+	for _, evt := range events {
+		if evt == pl.Action {
+			found = true
+			break
+		}
+	}
+	// event not defined to be parsed
+	if !found {
+		return nil, ErrEventNotFound
+	}
+
+	if pl.Action != PingEvent && !hook.inScope(pl.Target) {
+		return nil, ErrScopeNotFound
+	}
+
+	switch pl.Action {
+	case PushEvent, DeleteEvent, QuarantineEvent:
+		var fpl ImagePayload
+		err = json.Unmarshal([]byte(payload), &fpl)
+		return fpl, err
+	case ChartPushEvent, ChartDeleteEvent:
+		var fpl ChartPayload
+		err = json.Unmarshal([]byte(payload), &fpl)
+		return fpl, err
+	case PingEvent:
+		var fpl PingPayload
+		err = json.Unmarshal([]byte(payload), &fpl)
+		return fpl, err
+	default:
+		return nil, fmt.Errorf("unknown event %s", pl.Action)
+	}
+}
+
+func (hook Webhook) verifyHeaders(r *http.Request) bool {
+	for _, h := range hook.headers {
+		valueHash := sha512.Sum512([]byte(r.Header.Get(h.name)))
+		if subtle.ConstantTimeCompare(valueHash[:], h.hash) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (hook Webhook) inScope(target Target) bool {
+	// skip validation if no scope was provided
+	if len(hook.scopes) == 0 {
+		return true
+	}
+	for _, scope := range hook.scopes {
+		if matched, _ := path.Match(scope, target.Repository+":"+target.Tag); matched {
+			return true
+		}
+		// events without a tag, such as a delete by digest, are matched on the repository alone
+		if len(target.Tag) == 0 {
+			if matched, _ := path.Match(scope, target.Repository+":*"); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
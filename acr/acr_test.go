@@ -0,0 +1,274 @@
+package acr
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	virtualDir = "/webhooks"
+)
+
+var hook *Webhook
+
+func TestMain(m *testing.M) {
+
+	// setup
+	var err error
+	hook, err = New(Options.Header("Authorization", "Bearer sampleToken!"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+	// teardown
+}
+
+func newServer(handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(virtualDir, handler)
+	return httptest.NewServer(mux)
+}
+
+func TestWebhooks(t *testing.T) {
+	assert := require.New(t)
+	tests := []struct {
+		name     string
+		event    Event
+		typ      interface{}
+		filename string
+	}{
+		{
+			name:     "PushEvent",
+			event:    PushEvent,
+			typ:      ImagePayload{},
+			filename: "../testdata/acr/push.json",
+		},
+		{
+			name:     "DeleteEvent",
+			event:    DeleteEvent,
+			typ:      ImagePayload{},
+			filename: "../testdata/acr/delete.json",
+		},
+		{
+			name:     "QuarantineEvent",
+			event:    QuarantineEvent,
+			typ:      ImagePayload{},
+			filename: "../testdata/acr/quarantine.json",
+		},
+		{
+			name:     "ChartPushEvent",
+			event:    ChartPushEvent,
+			typ:      ChartPayload{},
+			filename: "../testdata/acr/chart-push.json",
+		},
+		{
+			name:     "ChartDeleteEvent",
+			event:    ChartDeleteEvent,
+			typ:      ChartPayload{},
+			filename: "../testdata/acr/chart-delete.json",
+		},
+		{
+			name:     "PingEvent",
+			event:    PingEvent,
+			typ:      PingPayload{},
+			filename: "../testdata/acr/ping.json",
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			payload, err := os.Open(tc.filename)
+			assert.NoError(err)
+			defer func() {
+				_ = payload.Close()
+			}()
+
+			var parseError error
+			var results interface{}
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				results, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+virtualDir, payload)
+			assert.NoError(err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer sampleToken!")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.NoError(parseError)
+			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+		})
+	}
+}
+
+func TestParseHeaderAuth(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []Option
+		headers     http.Header
+		expectedErr error
+	}{
+		{
+			name:    "valid header",
+			options: []Option{Options.Header("X-Registry-Token", "s3cr3t")},
+			headers: http.Header{
+				"X-Registry-Token": []string{"s3cr3t"},
+			},
+		},
+		{
+			name: "valid headers",
+			options: []Option{
+				Options.Header("authorization", "Basic dXNlcjpwYXNz"),
+				Options.Header("X-Registry-Token", "s3cr3t"),
+			},
+			headers: http.Header{
+				"Authorization":    []string{"Basic dXNlcjpwYXNz"},
+				"X-Registry-Token": []string{"s3cr3t"},
+			},
+		},
+		{
+			name: "no header configured",
+		},
+		{
+			name:        "missing header",
+			options:     []Option{Options.Header("X-Registry-Token", "s3cr3t")},
+			headers:     http.Header{},
+			expectedErr: ErrHeaderAuthVerificationFailed,
+		},
+		{
+			name: "one of the headers invalid",
+			options: []Option{
+				Options.Header("Authorization", "Basic dXNlcjpwYXNz"),
+				Options.Header("X-Registry-Token", "s3cr3t"),
+			},
+			headers: http.Header{
+				"Authorization":    []string{"Basic dXNlcjpwYXNz"},
+				"X-Registry-Token": []string{"guess"},
+			},
+			expectedErr: ErrHeaderAuthVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := New(tt.options...)
+		assert.NoError(t, err)
+
+		payload, err := os.ReadFile("../testdata/acr/push.json")
+		assert.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(payload))
+		assert.NoError(t, err)
+		for k, v := range tt.headers {
+			r.Header[k] = v
+		}
+
+		_, err = h.Parse(r, PushEvent)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestEventFiltering(t *testing.T) {
+	tests := []struct {
+		name        string
+		scopes      []string
+		events      []Event
+		filename    string
+		expectedErr error
+	}{
+		{
+			name:     "subscribed event",
+			events:   []Event{PushEvent, DeleteEvent},
+			filename: "../testdata/acr/delete.json",
+		},
+		{
+			name:        "unsubscribed event",
+			events:      []Event{ChartPushEvent},
+			filename:    "../testdata/acr/push.json",
+			expectedErr: ErrEventNotFound,
+		},
+		{
+			name:        "no event",
+			filename:    "../testdata/acr/push.json",
+			expectedErr: ErrEventNotSpecifiedToParse,
+		},
+		{
+			name:     "scope on repository and tag",
+			scopes:   []string{"hello-world:v1"},
+			events:   []Event{PushEvent},
+			filename: "../testdata/acr/push.json",
+		},
+		{
+			name:     "scope with wildcard tag",
+			scopes:   []string{"nginx:*", "hello-world:*"},
+			events:   []Event{PushEvent},
+			filename: "../testdata/acr/push.json",
+		},
+		{
+			name:     "untagged target in scope",
+			scopes:   []string{"hello-world:*"},
+			events:   []Event{DeleteEvent},
+			filename: "../testdata/acr/delete.json",
+		},
+		{
+			name:        "untagged target out of a tag scope",
+			scopes:      []string{"hello-world:v1"},
+			events:      []Event{DeleteEvent},
+			filename:    "../testdata/acr/delete.json",
+			expectedErr: ErrScopeNotFound,
+		},
+		{
+			name:        "out of scope",
+			scopes:      []string{"hello-world:v2"},
+			events:      []Event{PushEvent},
+			filename:    "../testdata/acr/push.json",
+			expectedErr: ErrScopeNotFound,
+		},
+		{
+			name:     "ping ignores scope",
+			scopes:   []string{"hello-world:v2"},
+			events:   []Event{PingEvent},
+			filename: "../testdata/acr/ping.json",
+		},
+	}
+
+	for _, tt := range tests {
+		h, err := New(Options.Scope(tt.scopes...))
+		assert.NoError(t, err)
+
+		payload, err := os.ReadFile(tt.filename)
+		assert.NoError(t, err)
+		r, err := http.NewRequest(http.MethodPost, "", bytes.NewReader(payload))
+		assert.NoError(t, err)
+
+		_, err = h.Parse(r, tt.events...)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+	}
+}
+
+func TestScope(t *testing.T) {
+	_, err := New(Options.Scope("hello-world:["))
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package acr
+
+import "time"
+
+// BasicEvent contains the fields shared by every Azure Container Registry hook event
+type BasicEvent struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    Event     `json:"action"`
+	Target    Target    `json:"target"`
+}
+
+// ImagePayload contains the information for the registry's push, delete and quarantine events
+type ImagePayload struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    Event     `json:"action"`
+	Target    Target    `json:"target"`
+	Request   Request   `json:"request"`
+	Actor     Actor     `json:"actor"`
+	Source    Source    `json:"source"`
+}
+
+// ChartPayload contains the information for the registry's chart_push and chart_delete events
+type ChartPayload struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    Event     `json:"action"`
+	Target    Target    `json:"target"`
+	Request   Request   `json:"request"`
+	Actor     Actor     `json:"actor"`
+}
+
+// PingPayload contains the information for the registry's ping event, sent when a webhook is tested
+type PingPayload struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    Event     `json:"action"`
+	Target    Target    `json:"target"`
+	Request   Request   `json:"request"`
+	Actor     Actor     `json:"actor"`
+}
+
+// Target contains the image or chart the event is about
+type Target struct {
+	MediaType  string `json:"mediaType"`
+	Size       int64  `json:"size"`
+	Digest     string `json:"digest"`
+	Length     int64  `json:"length"`
+	Repository string `json:"repository"`
+	URL        string `json:"url,omitempty"`
+	Tag        string `json:"tag,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
+
+// Request contains the registry request which triggered the event
+type Request struct {
+	ID        string `json:"id"`
+	Addr      string `json:"addr,omitempty"`
+	Host      string `json:"host"`
+	Method    string `json:"method"`
+	UserAgent string `json:"useragent"`
+}
+
+// Actor contains the identity which initiated the event
+type Actor struct {
+	Name string `json:"name,omitempty"`
+}
+
+// Source contains the registry instance which generated the event
+type Source struct {
+	Addr       string `json:"addr,omitempty"`
+	InstanceID string `json:"instanceID,omitempty"`
+}
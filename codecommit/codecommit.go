@@ -0,0 +1,210 @@
+package codecommit
+
+// this package receives AWS CodeCommit repository triggers delivered through an Amazon SNS HTTP(S) subscription
+// https://docs.aws.amazon.com/codecommit/latest/userguide/how-to-notify-sns.html
+// https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// parse errors
+var (
+	ErrEventNotSpecifiedToParse     = errors.New("no Event specified to parse")
+	ErrInvalidHTTPMethod            = errors.New("invalid HTTP Method")
+	ErrMissingMessageTypeHeader     = errors.New("missing X-Amz-Sns-Message-Type Header")
+	ErrMessageTypeMismatch          = errors.New("X-Amz-Sns-Message-Type Header does not match the message Type")
+	ErrEventNotFound                = errors.New("event not defined to be parsed")
+	ErrParsingPayload               = errors.New("error parsing payload")
+	ErrTopicARNVerificationFailed   = errors.New("TopicArn verification failed")
+	ErrInvalidSigningCertURL        = errors.New("invalid SigningCertURL")
+	ErrUnsupportedSignatureVersion  = errors.New("unsupported SignatureVersion")
+	ErrFetchingSigningCert          = errors.New("error fetching signing certificate")
+	ErrSignatureVerificationFailed  = errors.New("signature verification failed")
+	ErrSubscriptionConfirmationFail = errors.New("subscription confirmation failed")
+	ErrInvalidSubscribeURL          = errors.New("invalid SubscribeURL")
+)
+
+// Event defines an Amazon SNS message type by the X-Amz-Sns-Message-Type Header
+type Event string
+
+// Amazon SNS message types
+const (
+	SubscriptionConfirmationEvent Event = "SubscriptionConfirmation"
+	UnsubscribeConfirmationEvent  Event = "UnsubscribeConfirmation"
+	NotificationEvent             Event = "Notification"
+)
+
+// Option is a configuration option for the webhook
+type Option func(*Webhook) error
+
+// Options is a namespace var for configuration options
+var Options = WebhookOptions{}
+
+// WebhookOptions is a namespace for configuration option methods
+type WebhookOptions struct{}
+
+// TopicARN restricts the accepted messages to the given SNS topics
+func (WebhookOptions) TopicARN(arns ...string) Option {
+	return func(hook *Webhook) error {
+		hook.topicARNs = append(hook.topicARNs, arns...)
+		return nil
+	}
+}
+
+// CertificateFetcher registers the function used to retrieve the SNS signing certificates,
+// replacing the default one which downloads them from the SigningCertURL
+func (WebhookOptions) CertificateFetcher(fetcher CertificateFetcher) Option {
+	return func(hook *Webhook) error {
+		if fetcher == nil {
+			return errors.New("nil CertificateFetcher")
+		}
+		hook.fetcher = fetcher
+		return nil
+	}
+}
+
+// HTTPClient registers the client used to download signing certificates and to confirm subscriptions
+func (WebhookOptions) HTTPClient(client *http.Client) Option {
+	return func(hook *Webhook) error {
+		if client == nil {
+			return errors.New("nil http.Client")
+		}
+		hook.client = client
+		return nil
+	}
+}
+
+// Webhook instance contains all methods needed to process events
+type Webhook struct {
+	topicARNs []string
+	fetcher   CertificateFetcher
+	client    *http.Client
+}
+
+// New creates and returns a WebHook instance denoted by the Provider type
+func New(options ...Option) (*Webhook, error) {
+	hook := &Webhook{
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	for _, opt := range options {
+		if err := opt(hook); err != nil {
+			return nil, errors.New("Error applying Option")
+		}
+	}
+	if hook.fetcher == nil {
+		hook.fetcher = newCachedFetcher(hook.client)
+	}
+	return hook, nil
+}
+
+// Parse verifies and parses the events specified and returns the payload object or an error
+func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+
+	if len(events) == 0 {
+		return nil, ErrEventNotSpecifiedToParse
+	}
+	if r.Method != http.MethodPost {
+		return nil, ErrInvalidHTTPMethod
+	}
+
+	event := r.Header.Get("X-Amz-Sns-Message-Type")
+	if len(event) == 0 {
+		return nil, ErrMissingMessageTypeHeader
+	}
+
+	snsEvent := Event(event)
+
+	var found bool
+	for _, evt := range events {
+		if evt == snsEvent {
+			found = true
+			break
+		}
+	}
+	// event not defined to be parsed
+	if !found {
+		return nil, ErrEventNotFound
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, ErrParsingPayload
+	}
+
+	var envelope Envelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, ErrParsingPayload
+	}
+	if Event(envelope.Type) != snsEvent {
+		return nil, ErrMessageTypeMismatch
+	}
+
+	if len(hook.topicARNs) > 0 {
+		var allowed bool
+		for _, arn := range hook.topicARNs {
+			if arn == envelope.TopicARN {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, ErrTopicARNVerificationFailed
+		}
+	}
+
+	// SNS messages are always signed, unlike the shared secrets of other providers
+	if err := verifySignature(envelope, hook.fetcher); err != nil {
+		return nil, err
+	}
+
+	switch snsEvent {
+	case SubscriptionConfirmationEvent:
+		return SubscriptionConfirmationPayload(envelope), nil
+	case UnsubscribeConfirmationEvent:
+		return UnsubscribeConfirmationPayload(envelope), nil
+	case NotificationEvent:
+		var msg struct {
+			Records []Record `json:"Records"`
+		}
+		err = json.Unmarshal([]byte(envelope.Message), &msg)
+		return NotificationPayload{Envelope: envelope, Records: msg.Records}, err
+	default:
+		return nil, fmt.Errorf("unknown event %s", snsEvent)
+	}
+}
+
+// ConfirmSubscription visits the SubscribeURL of a verified SubscriptionConfirmation message,
+// which must be done before SNS delivers any notification to the endpoint; the URL must be
+// served over HTTPS by SNS
+func (hook Webhook) ConfirmSubscription(pl SubscriptionConfirmationPayload) error {
+	if len(pl.SubscribeURL) == 0 {
+		return ErrSubscriptionConfirmationFail
+	}
+	if !validSubscribeURL(pl.SubscribeURL) {
+		return ErrInvalidSubscribeURL
+	}
+
+	resp, err := hook.client.Get(pl.SubscribeURL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSubscriptionConfirmationFail, err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: unexpected status %d", ErrSubscriptionConfirmationFail, resp.StatusCode)
+	}
+	return nil
+}
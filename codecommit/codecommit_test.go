@@ -0,0 +1,364 @@
+package codecommit
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	path = "/webhooks"
+)
+
+var hook *Webhook
+
+// localCertificate serves the test signing certificate whatever the requested URL
+func localCertificate(string) (*x509.Certificate, error) {
+	data, err := os.ReadFile("../testdata/codecommit/signing-cert.pem")
+	if err != nil {
+		return nil, err
+	}
+	return ParseCertificate(data)
+}
+
+func TestMain(m *testing.M) {
+	// setup
+	var err error
+	hook, err = New(Options.CertificateFetcher(localCertificate))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+	// teardown
+}
+
+func newServer(handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, handler)
+	return httptest.NewServer(mux)
+}
+
+// fixture loads a test message and lets the caller tamper with it before re-encoding it
+func fixture(t *testing.T, filename string, modify func(m map[string]interface{})) io.Reader {
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	if modify == nil {
+		return bytes.NewReader(data)
+	}
+	var m map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &m))
+	modify(m)
+	data, err = json.Marshal(m)
+	require.NoError(t, err)
+	return bytes.NewReader(data)
+}
+
+func TestBadRequests(t *testing.T) {
+	restricted, err := New(
+		Options.CertificateFetcher(localCertificate),
+		Options.TopicARN("arn:aws:sns:us-east-2:123456789012:other-topic"),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		hook    *Webhook
+		event   Event
+		payload io.Reader
+		headers http.Header
+		err     error
+	}{
+		{
+			name:    "BadNoEventHeader",
+			event:   NotificationEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{},
+			err:     ErrMissingMessageTypeHeader,
+		},
+		{
+			name:    "UnsubscribedEvent",
+			event:   NotificationEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"noneexistant_event"},
+			},
+			err: ErrEventNotFound,
+		},
+		{
+			name:    "BadBody",
+			event:   NotificationEvent,
+			payload: bytes.NewBuffer([]byte("")),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrParsingPayload,
+		},
+		{
+			name:    "MessageTypeMismatch",
+			event:   NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/subscription-confirmation.json", nil),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrMessageTypeMismatch,
+		},
+		{
+			name:    "TopicARNMismatch",
+			hook:    restricted,
+			event:   NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", nil),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrTopicARNVerificationFailed,
+		},
+		{
+			name:  "ForeignSigningCertURL",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["SigningCertURL"] = "https://sns.us-east-2.amazonaws.com.example.com/cert.pem"
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrInvalidSigningCertURL,
+		},
+		{
+			name:  "NonPEMSigningCertURL",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["SigningCertURL"] = "https://sns.us-east-2.amazonaws.com/?Action=GetCertificate"
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrInvalidSigningCertURL,
+		},
+		{
+			name:  "PlainHTTPSigningCertURL",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["SigningCertURL"] = "http://sns.us-east-2.amazonaws.com/cert.pem"
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrInvalidSigningCertURL,
+		},
+		{
+			name:  "UnsupportedSignatureVersion",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["SignatureVersion"] = "3"
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrUnsupportedSignatureVersion,
+		},
+		{
+			name:  "TamperedMessage",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["Message"] = `{"Records":[]}`
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+		{
+			name:  "DowngradedSignatureVersion",
+			event: NotificationEvent,
+			payload: fixture(t, "../testdata/codecommit/notification.json", func(m map[string]interface{}) {
+				m["SignatureVersion"] = "1"
+			}),
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			h := hook
+			if tc.hook != nil {
+				h = tc.hook
+			}
+			var parseError error
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				_, parseError = h.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, tc.payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "text/plain; charset=UTF-8")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.Equal(tc.err, parseError)
+		})
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    Event
+		typ      interface{}
+		filename string
+		headers  http.Header
+	}{
+		{
+			name:     "SubscriptionConfirmationEvent",
+			event:    SubscriptionConfirmationEvent,
+			typ:      SubscriptionConfirmationPayload{},
+			filename: "../testdata/codecommit/subscription-confirmation.json",
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"SubscriptionConfirmation"},
+			},
+		},
+		{
+			name:     "UnsubscribeConfirmationEvent",
+			event:    UnsubscribeConfirmationEvent,
+			typ:      UnsubscribeConfirmationPayload{},
+			filename: "../testdata/codecommit/unsubscribe-confirmation.json",
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"UnsubscribeConfirmation"},
+			},
+		},
+		{
+			name:     "NotificationEvent",
+			event:    NotificationEvent,
+			typ:      NotificationPayload{},
+			filename: "../testdata/codecommit/notification.json",
+			headers: http.Header{
+				"X-Amz-Sns-Message-Type": []string{"Notification"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			payload, err := os.Open(tc.filename)
+			assert.NoError(err)
+			defer func() {
+				_ = payload.Close()
+			}()
+
+			var parseError error
+			var results interface{}
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				results, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "text/plain; charset=UTF-8")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.NoError(parseError)
+			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+		})
+	}
+}
+
+func TestNotificationRecords(t *testing.T) {
+	assert := require.New(t)
+	req := httptest.NewRequest(http.MethodPost, path, fixture(t, "../testdata/codecommit/notification.json", nil))
+	req.Header.Set("X-Amz-Sns-Message-Type", "Notification")
+
+	results, err := hook.Parse(req, NotificationEvent)
+	assert.NoError(err)
+	pl := results.(NotificationPayload)
+	assert.Equal("arn:aws:sns:us-east-2:123456789012:codecommit-triggers", pl.TopicARN)
+	assert.Len(pl.Records, 1)
+	assert.Equal("ReferenceChanges", pl.Records[0].EventName)
+	assert.Equal("arn:aws:codecommit:us-east-2:123456789012:MyDemoRepo", pl.Records[0].EventSourceARN)
+	assert.Len(pl.Records[0].CodeCommit.References, 2)
+	assert.Equal("refs/heads/main", pl.Records[0].CodeCommit.References[0].Ref)
+	assert.True(pl.Records[0].CodeCommit.References[0].Created)
+}
+
+// snsClient returns a client sending every request to the TLS server, whatever the SNS endpoint requested
+func snsClient(server *httptest.Server) *http.Client {
+	client := server.Client()
+	transport := client.Transport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	// the certificate of the test server is valid for example.com
+	transport.TLSClientConfig.ServerName = "example.com"
+	client.Transport = transport
+	return client
+}
+
+func TestConfirmSubscription(t *testing.T) {
+	assert := require.New(t)
+	var confirmed bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		confirmed = r.URL.Query().Get("Action") == "ConfirmSubscription"
+	}))
+	defer server.Close()
+
+	hook, err := New(Options.CertificateFetcher(localCertificate), Options.HTTPClient(snsClient(server)))
+	assert.NoError(err)
+
+	err = hook.ConfirmSubscription(SubscriptionConfirmationPayload{
+		SubscribeURL: "https://sns.us-east-2.amazonaws.com/?Action=ConfirmSubscription&Token=2336412f",
+	})
+	assert.NoError(err)
+	assert.True(confirmed)
+
+	err = hook.ConfirmSubscription(SubscriptionConfirmationPayload{})
+	assert.True(errors.Is(err, ErrSubscriptionConfirmationFail))
+
+	confirmed = false
+	for _, subscribeURL := range []string{
+		server.URL + "/?Action=ConfirmSubscription&Token=2336412f",
+		"http://sns.us-east-2.amazonaws.com/?Action=ConfirmSubscription&Token=2336412f",
+		"https://sns.us-east-2.amazonaws.com.example.com/?Action=ConfirmSubscription&Token=2336412f",
+		"https://169.254.169.254/latest/meta-data/",
+	} {
+		err = hook.ConfirmSubscription(SubscriptionConfirmationPayload{SubscribeURL: subscribeURL})
+		assert.Equal(ErrInvalidSubscribeURL, err, subscribeURL)
+	}
+	assert.False(confirmed)
+}
@@ -0,0 +1,66 @@
+package codecommit
+
+// Envelope contains the fields shared by every Amazon SNS message posted to an HTTP(S) endpoint
+type Envelope struct {
+	Type              string                      `json:"Type"`
+	MessageID         string                      `json:"MessageId"`
+	Token             string                      `json:"Token,omitempty"`
+	TopicARN          string                      `json:"TopicArn"`
+	Subject           string                      `json:"Subject,omitempty"`
+	Message           string                      `json:"Message"`
+	SubscribeURL      string                      `json:"SubscribeURL,omitempty"`
+	UnsubscribeURL    string                      `json:"UnsubscribeURL,omitempty"`
+	Timestamp         string                      `json:"Timestamp"`
+	SignatureVersion  string                      `json:"SignatureVersion"`
+	Signature         string                      `json:"Signature"`
+	SigningCertURL    string                      `json:"SigningCertURL"`
+	MessageAttributes map[string]MessageAttribute `json:"MessageAttributes,omitempty"`
+}
+
+// MessageAttribute contains an SNS message attribute
+type MessageAttribute struct {
+	Type  string `json:"Type"`
+	Value string `json:"Value"`
+}
+
+// SubscriptionConfirmationPayload contains the information for SNS's SubscriptionConfirmation message,
+// the subscription must be confirmed by visiting SubscribeURL, see Webhook.ConfirmSubscription
+type SubscriptionConfirmationPayload Envelope
+
+// UnsubscribeConfirmationPayload contains the information for SNS's UnsubscribeConfirmation message
+type UnsubscribeConfirmationPayload Envelope
+
+// NotificationPayload contains the information for SNS's Notification message with the
+// CodeCommit trigger records unwrapped from its Message
+type NotificationPayload struct {
+	Envelope
+	Records []Record `json:"Records"`
+}
+
+// Record contains a CodeCommit repository trigger event
+type Record struct {
+	AWSRegion  string `json:"awsRegion"`
+	CodeCommit struct {
+		References []Reference `json:"references"`
+	} `json:"codecommit"`
+	CustomData           string `json:"customData"`
+	EventID              string `json:"eventId"`
+	EventName            string `json:"eventName"`
+	EventPartNumber      int64  `json:"eventPartNumber"`
+	EventSource          string `json:"eventSource"`
+	EventSourceARN       string `json:"eventSourceARN"`
+	EventTime            string `json:"eventTime"`
+	EventTotalParts      int64  `json:"eventTotalParts"`
+	EventTriggerConfigID string `json:"eventTriggerConfigId"`
+	EventTriggerName     string `json:"eventTriggerName"`
+	EventVersion         string `json:"eventVersion"`
+	UserIdentityARN      string `json:"userIdentityARN"`
+}
+
+// Reference contains a CodeCommit reference change
+type Reference struct {
+	Commit  string `json:"commit"`
+	Ref     string `json:"ref"`
+	Created bool   `json:"created,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
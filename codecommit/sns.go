@@ -0,0 +1,180 @@
+package codecommit
+
+// https://docs.aws.amazon.com/sns/latest/dg/sns-verify-signature-of-message.html
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// CertificateFetcher retrieves the certificate published at an already validated SigningCertURL
+type CertificateFetcher func(certURL string) (*x509.Certificate, error)
+
+// signingCertHost matches the regional SNS endpoints allowed to host signing certificates and
+// subscription confirmations
+var signingCertHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// ParseCertificate decodes the first PEM encoded certificate found in data, it can be used
+// to build a CertificateFetcher serving certificates from local files
+func ParseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%w: no PEM certificate found", ErrFetchingSigningCert)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// newCachedFetcher returns the default CertificateFetcher, which downloads the certificates
+// with client and keeps them in memory since SNS reuses the same one for every message
+func newCachedFetcher(client *http.Client) CertificateFetcher {
+	var (
+		mu    sync.RWMutex
+		certs = make(map[string]*x509.Certificate)
+	)
+	return func(certURL string) (*x509.Certificate, error) {
+		mu.RLock()
+		cert, ok := certs[certURL]
+		mu.RUnlock()
+		if ok {
+			return cert, nil
+		}
+
+		resp, err := client.Get(certURL)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		cert, err = ParseCertificate(data)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		certs[certURL] = cert
+		mu.Unlock()
+		return cert, nil
+	}
+}
+
+// validSigningCertURL makes sure the certificate is served over HTTPS by SNS itself,
+// otherwise anyone could sign a forged message with their own certificate
+func validSigningCertURL(certURL string) bool {
+	u, ok := parseSNSURL(certURL)
+	return ok && strings.HasSuffix(u.Path, ".pem")
+}
+
+// validSubscribeURL makes sure confirming a subscription only reaches SNS, the SubscribeURL
+// being visited by the endpoint itself
+func validSubscribeURL(subscribeURL string) bool {
+	_, ok := parseSNSURL(subscribeURL)
+	return ok
+}
+
+// parseSNSURL parses a URL, reporting whether it is served over HTTPS by a regional SNS endpoint
+func parseSNSURL(rawURL string) (*url.URL, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, false
+	}
+	return u, u.Scheme == "https" && signingCertHost.MatchString(u.Hostname())
+}
+
+// stringToSign builds the canonical representation of the message covered by its Signature
+func stringToSign(e Envelope) string {
+	var fields [][2]string
+	switch Event(e.Type) {
+	case NotificationEvent:
+		fields = append(fields, [2]string{"Message", e.Message}, [2]string{"MessageId", e.MessageID})
+		if len(e.Subject) > 0 {
+			fields = append(fields, [2]string{"Subject", e.Subject})
+		}
+		fields = append(fields,
+			[2]string{"Timestamp", e.Timestamp},
+			[2]string{"TopicArn", e.TopicARN},
+			[2]string{"Type", e.Type},
+		)
+	default:
+		fields = [][2]string{
+			{"Message", e.Message},
+			{"MessageId", e.MessageID},
+			{"SubscribeURL", e.SubscribeURL},
+			{"Timestamp", e.Timestamp},
+			{"Token", e.Token},
+			{"TopicArn", e.TopicARN},
+			{"Type", e.Type},
+		}
+	}
+
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(f[0])
+		b.WriteByte('\n')
+		b.WriteString(f[1])
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// verifySignature checks the RSA signature of the message against its signing certificate
+func verifySignature(e Envelope, fetcher CertificateFetcher) error {
+	if !validSigningCertURL(e.SigningCertURL) {
+		return ErrInvalidSigningCertURL
+	}
+
+	var (
+		hash   crypto.Hash
+		digest []byte
+	)
+	switch e.SignatureVersion {
+	case "1":
+		sum := sha1.Sum([]byte(stringToSign(e)))
+		hash, digest = crypto.SHA1, sum[:]
+	case "2":
+		sum := sha256.Sum256([]byte(stringToSign(e)))
+		hash, digest = crypto.SHA256, sum[:]
+	default:
+		return ErrUnsupportedSignatureVersion
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(e.Signature)
+	if err != nil {
+		return ErrSignatureVerificationFailed
+	}
+
+	cert, err := fetcher(e.SigningCertURL)
+	if err != nil || cert == nil {
+		return ErrFetchingSigningCert
+	}
+	pub, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return ErrSignatureVerificationFailed
+	}
+
+	if err := rsa.VerifyPKCS1v15(pub, hash, digest, signature); err != nil {
+		return ErrSignatureVerificationFailed
+	}
+	return nil
+}
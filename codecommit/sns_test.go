@@ -0,0 +1,74 @@
+package codecommit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedFetcher(t *testing.T) {
+	assert := require.New(t)
+
+	pem, err := os.ReadFile("../testdata/codecommit/signing-cert.pem")
+	assert.NoError(err)
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/SimpleNotificationService.pem":
+			_, _ = w.Write(pem)
+		case "/invalid.pem":
+			_, _ = w.Write([]byte("<html>not a certificate</html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := newCachedFetcher(snsClient(server))
+	const certURL = "https://sns.us-east-2.amazonaws.com/SimpleNotificationService.pem"
+
+	// the certificate is downloaded once
+	cert, err := fetcher(certURL)
+	assert.NoError(err)
+	assert.NotNil(cert)
+	cached, err := fetcher(certURL)
+	assert.NoError(err)
+	assert.Same(cert, cached)
+	assert.Equal(int32(1), atomic.LoadInt32(&requests))
+
+	_, err = fetcher("https://sns.us-east-2.amazonaws.com/invalid.pem")
+	assert.True(errors.Is(err, ErrFetchingSigningCert))
+	_, err = fetcher("https://sns.us-east-2.amazonaws.com/missing.pem")
+	assert.Error(err)
+
+	// failures are not cached
+	_, err = fetcher("https://sns.us-east-2.amazonaws.com/invalid.pem")
+	assert.Error(err)
+	assert.Equal(int32(4), atomic.LoadInt32(&requests))
+}
+
+func TestValidSNSURLs(t *testing.T) {
+	assert := require.New(t)
+
+	for certURL, valid := range map[string]bool{
+		"https://sns.us-east-2.amazonaws.com/SimpleNotificationService.pem":     true,
+		"https://sns.cn-north-1.amazonaws.com.cn/SimpleNotificationService.pem": true,
+		"https://sns.us-east-2.amazonaws.com/SimpleNotificationService.crt":     false,
+		"http://sns.us-east-2.amazonaws.com/SimpleNotificationService.pem":      false,
+		"https://sns.us-east-2.amazonaws.com.evil.com/cert.pem":                 false,
+		"https://s3.amazonaws.com/sns/cert.pem":                                 false,
+		"https://attacker.example.com/sns.us-east-2.amazonaws.com/cert.pem":     false,
+	} {
+		assert.Equal(valid, validSigningCertURL(certURL), certURL)
+	}
+
+	assert.True(validSubscribeURL("https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription&Token=2336412f"))
+	assert.False(validSubscribeURL("https://sns.eu-west-1.amazonaws.com.evil.com/?Action=ConfirmSubscription"))
+	assert.False(validSubscribeURL("://sns.eu-west-1.amazonaws.com"))
+}
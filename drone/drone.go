@@ -0,0 +1,186 @@
+package drone
+
+// this package receives Drone and Woodpecker CI global webhooks, signed with HTTP Signatures
+// https://docs.drone.io/webhooks/overview/
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/webhooks/v6/httpsig"
+)
+
+// parse errors
+var (
+	ErrEventNotSpecifiedToParse = errors.New("no Event specified to parse")
+	ErrInvalidHTTPMethod        = errors.New("invalid HTTP Method")
+	ErrMissingDroneEventHeader  = errors.New("missing X-Drone-Event Header")
+	ErrEventNotFound            = errors.New("event not defined to be parsed")
+	ErrParsingPayload           = errors.New("error parsing payload")
+)
+
+// RequiredHeaders are the headers a delivery signature must cover; the (request-target) is verified
+// as well whenever the sender includes it
+var RequiredHeaders = []string{"date", "digest"}
+
+// Event defines a Drone hook event type by the X-Drone-Event Header
+type Event string
+
+// Drone hook types
+const (
+	UserEvent  Event = "user"
+	RepoEvent  Event = "repo"
+	BuildEvent Event = "build"
+)
+
+// Drone hook actions
+const (
+	CreatedAction  = "created"
+	UpdatedAction  = "updated"
+	DeletedAction  = "deleted"
+	EnabledAction  = "enabled"
+	DisabledAction = "disabled"
+)
+
+// Option is a configuration option for the webhook
+type Option func(*Webhook) error
+
+// Options is a namespace var for configuration options
+var Options = WebhookOptions{}
+
+// WebhookOptions is a namespace for configuration option methods
+type WebhookOptions struct{}
+
+// Secret registers the shared secret, DRONE_WEBHOOK_SECRET, verifying hmac-sha256 signatures
+// whatever their keyId
+func (WebhookOptions) Secret(secret string) Option {
+	return func(hook *Webhook) error {
+		if len(secret) == 0 {
+			return errors.New("empty secret")
+		}
+		hook.secret = []byte(secret)
+		return nil
+	}
+}
+
+// PublicKey registers an ed25519 public key verifying the signatures carrying keyID
+func (WebhookOptions) PublicKey(keyID string, key ed25519.PublicKey) Option {
+	return func(hook *Webhook) error {
+		if len(key) != ed25519.PublicKeySize {
+			return errors.New("invalid ed25519 public key")
+		}
+		if hook.publicKeys == nil {
+			hook.publicKeys = make(map[string]ed25519.PublicKey)
+		}
+		hook.publicKeys[keyID] = key
+		return nil
+	}
+}
+
+// MaxSkew sets the maximum accepted difference between the Date Header and the current time;
+// the check is disabled by default
+func (WebhookOptions) MaxSkew(skew time.Duration) Option {
+	return func(hook *Webhook) error {
+		hook.verifier.MaxSkew = skew
+		return nil
+	}
+}
+
+// Webhook instance contains all methods needed to process events
+type Webhook struct {
+	secret     []byte
+	publicKeys map[string]ed25519.PublicKey
+	verifier   httpsig.Verifier
+}
+
+// New creates and returns a WebHook instance denoted by the Provider type
+func New(options ...Option) (*Webhook, error) {
+	hook := new(Webhook)
+	for _, opt := range options {
+		if err := opt(hook); err != nil {
+			return nil, errors.New("Error applying Option")
+		}
+	}
+	hook.verifier.Keys = hook.key
+	hook.verifier.Required = RequiredHeaders
+	return hook, nil
+}
+
+// Parse verifies and parses the events specified and returns the payload object or an error
+func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+
+	if len(events) == 0 {
+		return nil, ErrEventNotSpecifiedToParse
+	}
+	if r.Method != http.MethodPost {
+		return nil, ErrInvalidHTTPMethod
+	}
+
+	event := r.Header.Get("X-Drone-Event")
+	if len(event) == 0 {
+		return nil, ErrMissingDroneEventHeader
+	}
+
+	droneEvent := Event(event)
+
+	var found bool
+	for _, evt := range events {
+		if evt == droneEvent {
+			found = true
+			break
+		}
+	}
+	// event not defined to be parsed
+	if !found {
+		return nil, ErrEventNotFound
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, ErrParsingPayload
+	}
+
+	// If we have a key set, we should check the signature and the digest
+	if len(hook.secret) > 0 || len(hook.publicKeys) > 0 {
+		if err := hook.verifier.Verify(r, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	switch droneEvent {
+	case UserEvent:
+		var pl UserPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case RepoEvent:
+		var pl RepoPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case BuildEvent:
+		var pl BuildPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	default:
+		return nil, fmt.Errorf("unknown event %s", droneEvent)
+	}
+}
+
+// key resolves the keyId of a signature, public keys taking precedence over the shared secret
+func (hook Webhook) key(keyID string) (interface{}, error) {
+	if key, ok := hook.publicKeys[keyID]; ok {
+		return key, nil
+	}
+	if len(hook.secret) > 0 {
+		return hook.secret, nil
+	}
+	return nil, httpsig.ErrUnknownKey
+}
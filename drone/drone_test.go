@@ -0,0 +1,297 @@
+package drone
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-playground/webhooks/v6/httpsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	path   = "/webhooks"
+	secret = "sampleSecret!"
+)
+
+var hook *Webhook
+
+func TestMain(m *testing.M) {
+
+	// setup
+	var err error
+	hook, err = New(Options.Secret(secret))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+
+	// teardown
+}
+
+func newServer(handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, handler)
+	return httptest.NewServer(mux)
+}
+
+func TestBadRequests(t *testing.T) {
+	assert := require.New(t)
+	tests := []struct {
+		name    string
+		event   Event
+		payload io.Reader
+		headers http.Header
+	}{
+		{
+			name:    "BadNoEventHeader",
+			event:   BuildEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{},
+		},
+		{
+			name:    "UnsubscribedEvent",
+			event:   BuildEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Drone-Event": []string{"noneexistant_event"},
+			},
+		},
+		{
+			name:    "BadBody",
+			event:   BuildEvent,
+			payload: bytes.NewBuffer([]byte("")),
+			headers: http.Header{
+				"X-Drone-Event": []string{"build"},
+			},
+		},
+		{
+			name:    "MissingSignature",
+			event:   BuildEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Drone-Event": []string{"build"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var parseError error
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				_, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, tc.payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.Error(parseError)
+		})
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	assert := require.New(t)
+	tests := []struct {
+		name     string
+		event    Event
+		typ      interface{}
+		filename string
+		headers  http.Header
+	}{
+		{
+			name:     "UserEvent",
+			event:    UserEvent,
+			typ:      UserPayload{},
+			filename: "../testdata/drone/user.json",
+			headers: http.Header{
+				"X-Drone-Event": []string{"user"},
+			},
+		},
+		{
+			name:     "RepoEvent",
+			event:    RepoEvent,
+			typ:      RepoPayload{},
+			filename: "../testdata/drone/repo.json",
+			headers: http.Header{
+				"X-Drone-Event": []string{"repo"},
+			},
+		},
+		{
+			name:     "BuildEvent",
+			event:    BuildEvent,
+			typ:      BuildPayload{},
+			filename: "../testdata/drone/build.json",
+			headers: http.Header{
+				"X-Drone-Event": []string{"build"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			payload, err := os.ReadFile(tc.filename)
+			assert.NoError(err)
+
+			var parseError error
+			var results interface{}
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				results, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, bytes.NewReader(payload))
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+			// Drone signs the date and digest headers only
+			assert.NoError(httpsig.Sign(req, payload, "hmac-key", httpsig.AlgorithmHmacSha256, []byte(secret), "date", "digest"))
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.NoError(parseError)
+			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+		})
+	}
+}
+
+func TestSignedWebhooks(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	signed, err := New(Options.PublicKey("woodpecker", public), Options.MaxSkew(time.Minute))
+	require.NoError(t, err)
+
+	payload, err := os.ReadFile("../testdata/drone/build.json")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		sign   func(r *http.Request) error
+		tamper func(r *http.Request)
+		err    error
+	}{
+		{
+			name: "Ed25519",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, payload, "woodpecker", httpsig.AlgorithmEd25519, private)
+			},
+		},
+		{
+			name: "UnknownKeyID",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, payload, "drone", httpsig.AlgorithmEd25519, private)
+			},
+			err: httpsig.ErrUnknownKey,
+		},
+		{
+			name: "HmacWithoutSecret",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, payload, "woodpecker", httpsig.AlgorithmHmacSha256, []byte(secret))
+			},
+			err: httpsig.ErrInvalidKey,
+		},
+		{
+			name: "TamperedTarget",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, payload, "woodpecker", httpsig.AlgorithmEd25519, private)
+			},
+			tamper: func(r *http.Request) {
+				r.URL.Path = "/other"
+			},
+			err: httpsig.ErrSignatureVerificationFailed,
+		},
+		{
+			name: "TamperedEvent",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, []byte(`{"event":"build"}`), "woodpecker", httpsig.AlgorithmEd25519, private)
+			},
+			err: httpsig.ErrDigestVerificationFailed,
+		},
+		{
+			name: "DigestNotSigned",
+			sign: func(r *http.Request) error {
+				return httpsig.Sign(r, payload, "woodpecker", httpsig.AlgorithmEd25519, private, httpsig.RequestTarget, "date")
+			},
+			err: httpsig.ErrRequiredHeaderNotSigned,
+		},
+		{
+			name: "StaleDate",
+			sign: func(r *http.Request) error {
+				r.Header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				return httpsig.Sign(r, payload, "woodpecker", httpsig.AlgorithmEd25519, private)
+			},
+			err: httpsig.ErrDateVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Drone-Event", "build")
+			assert.NoError(tc.sign(req))
+			if tc.tamper != nil {
+				tc.tamper(req)
+			}
+
+			results, err := signed.Parse(req, BuildEvent)
+			if tc.err != nil {
+				assert.True(errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+				return
+			}
+			assert.NoError(err)
+			build, ok := results.(BuildPayload)
+			assert.True(ok)
+			assert.Equal("octocat/hello-world", build.Repo.Slug)
+			assert.Equal(int64(7), build.Build.Number)
+			assert.Len(build.Build.Stages, 1)
+			assert.Len(build.Build.Stages[0].Steps, 2)
+			assert.Equal("2.20.0", build.System.Version)
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	_, err := New(Options.Secret(""))
+	assert.Error(t, err)
+
+	_, err = New(Options.PublicKey("woodpecker", ed25519.PublicKey("short")))
+	assert.Error(t, err)
+}
@@ -0,0 +1,218 @@
+package gitee
+
+// this package receives Gitee WebHooks
+// https://gitee.com/help/articles/4271
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// parse errors
+var (
+	ErrEventNotSpecifiedToParse     = errors.New("no Event specified to parse")
+	ErrInvalidHTTPMethod            = errors.New("invalid HTTP Method")
+	ErrMissingGiteeEventHeader      = errors.New("missing X-Gitee-Event Header")
+	ErrMissingGiteeTokenHeader      = errors.New("missing X-Gitee-Token Header")
+	ErrMissingGiteeTimestampHeader  = errors.New("missing X-Gitee-Timestamp Header")
+	ErrInvalidGiteeTimestampHeader  = errors.New("invalid X-Gitee-Timestamp Header")
+	ErrGiteeTokenVerificationFailed = errors.New("X-Gitee-Token validation failed")
+	ErrTimestampVerificationFailed  = errors.New("timestamp outside of the allowed tolerance")
+	ErrEventNotFound                = errors.New("event not defined to be parsed")
+	ErrParsingPayload               = errors.New("error parsing payload")
+)
+
+// Gitee hook types
+const (
+	PushEvents         Event = "Push Hook"
+	TagEvents          Event = "Tag Push Hook"
+	IssuesEvents       Event = "Issue Hook"
+	MergeRequestEvents Event = "Merge Request Hook"
+	CommentEvents      Event = "Note Hook"
+)
+
+// DefaultTolerance is the maximum difference between the X-Gitee-Timestamp Header and the current time
+// accepted when a signing key is registered and no other tolerance is configured
+const DefaultTolerance = 5 * time.Minute
+
+// Event defines a Gitee hook event type by the X-Gitee-Event Header
+type Event string
+
+// Option is a configuration option for the webhook
+type Option func(*Webhook) error
+
+// Options is a namespace var for configuration options
+var Options = WebhookOptions{}
+
+// WebhookOptions is a namespace for configuration option methods
+type WebhookOptions struct{}
+
+// Password registers the Gitee WebHook password, sent as is in the X-Gitee-Token Header
+func (WebhookOptions) Password(password string) Option {
+	return func(hook *Webhook) error {
+		// already convert here to prevent timing attack (conversion depends on secret)
+		hash := sha512.Sum512([]byte(password))
+		hook.passwordHash = hash[:]
+		return nil
+	}
+}
+
+// SignKey registers the Gitee WebHook signing key, the X-Gitee-Token Header then holds
+// an HMAC-SHA256 signature of the X-Gitee-Timestamp Header
+func (WebhookOptions) SignKey(key string) Option {
+	return func(hook *Webhook) error {
+		hook.signKey = key
+		return nil
+	}
+}
+
+// Tolerance sets the maximum accepted difference between the X-Gitee-Timestamp Header and the
+// current time when a signing key is registered, DefaultTolerance unless set; a zero or negative
+// tolerance disables the timestamp check
+func (WebhookOptions) Tolerance(tolerance time.Duration) Option {
+	return func(hook *Webhook) error {
+		hook.tolerance = tolerance
+		return nil
+	}
+}
+
+// Webhook instance contains all methods needed to process events
+type Webhook struct {
+	passwordHash []byte
+	signKey      string
+	tolerance    time.Duration
+	now          func() time.Time
+}
+
+// New creates and returns a WebHook instance denoted by the Provider type
+func New(options ...Option) (*Webhook, error) {
+	hook := &Webhook{
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+	for _, opt := range options {
+		if err := opt(hook); err != nil {
+			return nil, errors.New("Error applying Option")
+		}
+	}
+	return hook, nil
+}
+
+// Parse verifies and parses the events specified and returns the payload object or an error
+func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+
+	if len(events) == 0 {
+		return nil, ErrEventNotSpecifiedToParse
+	}
+	if r.Method != http.MethodPost {
+		return nil, ErrInvalidHTTPMethod
+	}
+
+	if err := hook.verifyToken(r.Header.Get("X-Gitee-Token"), r.Header.Get("X-Gitee-Timestamp")); err != nil {
+		return nil, err
+	}
+
+	event := r.Header.Get("X-Gitee-Event")
+	if len(event) == 0 {
+		return nil, ErrMissingGiteeEventHeader
+	}
+
+	giteeEvent := Event(event)
+
+	var found bool
+	for _, evt := range events {
+		if evt == giteeEvent {
+			found = true
+			break
+		}
+	}
+	// event not defined to be parsed
+	if !found {
+		return nil, ErrEventNotFound
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, ErrParsingPayload
+	}
+
+	switch giteeEvent {
+	case PushEvents:
+		var pl PushEventPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case TagEvents:
+		var pl TagEventPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case IssuesEvents:
+		var pl IssueEventPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case MergeRequestEvents:
+		var pl MergeRequestEventPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case CommentEvents:
+		var pl CommentEventPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	default:
+		return nil, fmt.Errorf("unknown event %s", giteeEvent)
+	}
+}
+
+// verifyToken checks the X-Gitee-Token Header according to the registered password or signing key
+func (hook Webhook) verifyToken(token, timestamp string) error {
+	switch {
+	case len(hook.signKey) > 0:
+		if len(token) == 0 {
+			return ErrMissingGiteeTokenHeader
+		}
+		if len(timestamp) == 0 {
+			return ErrMissingGiteeTimestampHeader
+		}
+		millis, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return ErrInvalidGiteeTimestampHeader
+		}
+
+		mac := hmac.New(sha256.New, []byte(hook.signKey))
+		_, _ = mac.Write([]byte(timestamp + "\n" + hook.signKey))
+		expectedMAC := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+		if !hmac.Equal([]byte(token), []byte(expectedMAC)) {
+			return ErrGiteeTokenVerificationFailed
+		}
+
+		if hook.tolerance > 0 {
+			diff := hook.now().Sub(time.Unix(0, millis*int64(time.Millisecond)))
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff > hook.tolerance {
+				return ErrTimestampVerificationFailed
+			}
+		}
+	case len(hook.passwordHash) > 0:
+		// check in constant time
+		tokenHash := sha512.Sum512([]byte(token))
+		if subtle.ConstantTimeCompare(tokenHash[:], hook.passwordHash) == 0 {
+			return ErrGiteeTokenVerificationFailed
+		}
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package gitee
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	path    = "/webhooks"
+	signKey = "sampleSignKey!"
+)
+
+var hook *Webhook
+
+func TestMain(m *testing.M) {
+
+	// setup
+	var err error
+	hook, err = New(Options.Password("sampleToken!"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+
+	// teardown
+}
+
+func newServer(handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, handler)
+	return httptest.NewServer(mux)
+}
+
+func sign(timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(signKey))
+	mac.Write([]byte(timestamp + "\n" + signKey))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestBadRequests(t *testing.T) {
+	assert := require.New(t)
+	tests := []struct {
+		name    string
+		event   Event
+		payload io.Reader
+		headers http.Header
+	}{
+		{
+			name:    "BadNoEventHeader",
+			event:   PushEvents,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Gitee-Token": []string{"sampleToken!"},
+			},
+		},
+		{
+			name:    "UnsubscribedEvent",
+			event:   PushEvents,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Gitee-Event": []string{"noneexistant_event"},
+				"X-Gitee-Token": []string{"sampleToken!"},
+			},
+		},
+		{
+			name:    "BadBody",
+			event:   PushEvents,
+			payload: bytes.NewBuffer([]byte("")),
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Push Hook"},
+				"X-Gitee-Token": []string{"sampleToken!"},
+			},
+		},
+		{
+			name:    "TokenMismatch",
+			event:   PushEvents,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Push Hook"},
+				"X-Gitee-Token": []string{"badsampleToken!!"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var parseError error
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				_, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, tc.payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.Error(parseError)
+		})
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	assert := require.New(t)
+	tests := []struct {
+		name     string
+		event    Event
+		typ      interface{}
+		filename string
+		headers  http.Header
+	}{
+		{
+			name:     "PushEvent",
+			event:    PushEvents,
+			typ:      PushEventPayload{},
+			filename: "../testdata/gitee/push-event.json",
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Push Hook"},
+			},
+		},
+		{
+			name:     "TagEvent",
+			event:    TagEvents,
+			typ:      TagEventPayload{},
+			filename: "../testdata/gitee/tag-event.json",
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Tag Push Hook"},
+			},
+		},
+		{
+			name:     "IssueEvent",
+			event:    IssuesEvents,
+			typ:      IssueEventPayload{},
+			filename: "../testdata/gitee/issue-event.json",
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Issue Hook"},
+			},
+		},
+		{
+			name:     "MergeRequestEvent",
+			event:    MergeRequestEvents,
+			typ:      MergeRequestEventPayload{},
+			filename: "../testdata/gitee/merge-request-event.json",
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Merge Request Hook"},
+			},
+		},
+		{
+			name:     "CommentIssueEvent",
+			event:    CommentEvents,
+			typ:      CommentEventPayload{},
+			filename: "../testdata/gitee/comment-issue-event.json",
+			headers: http.Header{
+				"X-Gitee-Event": []string{"Note Hook"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			payload, err := os.Open(tc.filename)
+			assert.NoError(err)
+			defer func() {
+				_ = payload.Close()
+			}()
+
+			var parseError error
+			var results interface{}
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				results, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Gitee-Token", "sampleToken!")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.NoError(parseError)
+			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+		})
+	}
+}
+
+func TestSignedWebhooks(t *testing.T) {
+	signed, err := New(Options.SignKey(signKey), Options.Tolerance(time.Hour))
+	require.NoError(t, err)
+
+	now := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+	old := strconv.FormatInt(time.Now().Add(-2*time.Hour).UnixNano()/int64(time.Millisecond), 10)
+
+	tests := []struct {
+		name    string
+		headers http.Header
+		err     error
+	}{
+		{
+			name: "ValidSignature",
+			headers: http.Header{
+				"X-Gitee-Token":     []string{sign(now)},
+				"X-Gitee-Timestamp": []string{now},
+			},
+		},
+		{
+			name: "MissingToken",
+			headers: http.Header{
+				"X-Gitee-Timestamp": []string{now},
+			},
+			err: ErrMissingGiteeTokenHeader,
+		},
+		{
+			name: "MissingTimestamp",
+			headers: http.Header{
+				"X-Gitee-Token": []string{sign(now)},
+			},
+			err: ErrMissingGiteeTimestampHeader,
+		},
+		{
+			name: "InvalidTimestamp",
+			headers: http.Header{
+				"X-Gitee-Token":     []string{sign("today")},
+				"X-Gitee-Timestamp": []string{"today"},
+			},
+			err: ErrInvalidGiteeTimestampHeader,
+		},
+		{
+			name: "PlainPassword",
+			headers: http.Header{
+				"X-Gitee-Token":     []string{signKey},
+				"X-Gitee-Timestamp": []string{now},
+			},
+			err: ErrGiteeTokenVerificationFailed,
+		},
+		{
+			name: "ReplayedTimestamp",
+			headers: http.Header{
+				"X-Gitee-Token":     []string{sign(now)},
+				"X-Gitee-Timestamp": []string{old},
+			},
+			err: ErrGiteeTokenVerificationFailed,
+		},
+		{
+			name: "ExpiredTimestamp",
+			headers: http.Header{
+				"X-Gitee-Token":     []string{sign(old)},
+				"X-Gitee-Timestamp": []string{old},
+			},
+			err: ErrTimestampVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			payload, err := os.ReadFile("../testdata/gitee/push-event.json")
+			assert.NoError(err)
+
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Gitee-Event", "Push Hook")
+
+			results, err := signed.Parse(req, PushEvents)
+			assert.Equal(tc.err, err)
+			if tc.err == nil {
+				assert.IsType(PushEventPayload{}, results)
+			}
+		})
+	}
+}
+
+func TestSignedWebhookTolerance(t *testing.T) {
+	assert := require.New(t)
+
+	payload, err := os.ReadFile("../testdata/gitee/push-event.json")
+	assert.NoError(err)
+	stale := strconv.FormatInt(time.Now().Add(-10*time.Minute).UnixNano()/int64(time.Millisecond), 10)
+	parse := func(hook *Webhook) error {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Gitee-Event", "Push Hook")
+		req.Header.Set("X-Gitee-Token", sign(stale))
+		req.Header.Set("X-Gitee-Timestamp", stale)
+		_, err := hook.Parse(req, PushEvents)
+		return err
+	}
+
+	// DefaultTolerance applies unless configured
+	defaults, err := New(Options.SignKey(signKey))
+	assert.NoError(err)
+	assert.Equal(ErrTimestampVerificationFailed, parse(defaults))
+
+	disabled, err := New(Options.SignKey(signKey), Options.Tolerance(0))
+	assert.NoError(err)
+	assert.NoError(parse(disabled))
+}
@@ -0,0 +1,333 @@
+package gitee
+
+import (
+	"strings"
+	"time"
+)
+
+type customTime struct {
+	time.Time
+}
+
+func (t *customTime) UnmarshalJSON(b []byte) (err error) {
+	layout := []string{
+		time.RFC3339,
+		"2006-01-02 15:04:05 Z07:00",
+		"2006-01-02 15:04:05",
+	}
+	s := strings.Trim(string(b), "\"")
+	if s == "null" || s == "" {
+		t.Time = time.Time{}
+		return
+	}
+	for _, l := range layout {
+		t.Time, err = time.Parse(l, s)
+		if err == nil {
+			break
+		}
+	}
+	return
+}
+
+// PushEventPayload contains the information for Gitee's push event
+type PushEventPayload struct {
+	HookName           string      `json:"hook_name"`
+	HookID             int64       `json:"hook_id"`
+	HookURL            string      `json:"hook_url"`
+	Password           string      `json:"password"`
+	Timestamp          string      `json:"timestamp"`
+	Sign               string      `json:"sign"`
+	Ref                string      `json:"ref"`
+	Before             string      `json:"before"`
+	After              string      `json:"after"`
+	Created            bool        `json:"created"`
+	Deleted            bool        `json:"deleted"`
+	Compare            string      `json:"compare"`
+	TotalCommitsCount  int64       `json:"total_commits_count"`
+	CommitsMoreThanTen bool        `json:"commits_more_than_ten"`
+	Commits            []Commit    `json:"commits"`
+	HeadCommit         *Commit     `json:"head_commit"`
+	Repository         Project     `json:"repository"`
+	Project            Project     `json:"project"`
+	UserID             int64       `json:"user_id"`
+	UserName           string      `json:"user_name"`
+	User               User        `json:"user"`
+	Pusher             User        `json:"pusher"`
+	Sender             User        `json:"sender"`
+	Enterprise         *Enterprise `json:"enterprise"`
+}
+
+// TagEventPayload contains the information for Gitee's tag push event
+type TagEventPayload struct {
+	// Tag pushes are currently sent with the same data as branch pushes,
+	// so we can just embed the push payload type here.
+	PushEventPayload
+}
+
+// IssueEventPayload contains the information for Gitee's issue event
+type IssueEventPayload struct {
+	HookName    string      `json:"hook_name"`
+	HookID      int64       `json:"hook_id"`
+	HookURL     string      `json:"hook_url"`
+	Password    string      `json:"password"`
+	Timestamp   string      `json:"timestamp"`
+	Sign        string      `json:"sign"`
+	Action      string      `json:"action"`
+	Issue       Issue       `json:"issue"`
+	Repository  Project     `json:"repository"`
+	Project     Project     `json:"project"`
+	Sender      User        `json:"sender"`
+	TargetUser  *User       `json:"target_user"`
+	User        User        `json:"user"`
+	Assignee    *User       `json:"assignee"`
+	UpdatedBy   *User       `json:"updated_by"`
+	IID         string      `json:"iid"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	State       string      `json:"state"`
+	Milestone   string      `json:"milestone"`
+	URL         string      `json:"url"`
+	Enterprise  *Enterprise `json:"enterprise"`
+}
+
+// MergeRequestEventPayload contains the information for Gitee's merge request (pull request) event
+type MergeRequestEventPayload struct {
+	HookName       string      `json:"hook_name"`
+	HookID         int64       `json:"hook_id"`
+	HookURL        string      `json:"hook_url"`
+	Password       string      `json:"password"`
+	Timestamp      string      `json:"timestamp"`
+	Sign           string      `json:"sign"`
+	Action         string      `json:"action"`
+	ActionDesc     string      `json:"action_desc"`
+	PullRequest    PullRequest `json:"pull_request"`
+	Number         int64       `json:"number"`
+	IID            int64       `json:"iid"`
+	Title          string      `json:"title"`
+	Body           string      `json:"body"`
+	State          string      `json:"state"`
+	MergeStatus    string      `json:"merge_status"`
+	MergeCommitSHA string      `json:"merge_commit_sha"`
+	URL            string      `json:"url"`
+	SourceBranch   string      `json:"source_branch"`
+	SourceRepo     RepoInfo    `json:"source_repo"`
+	TargetBranch   string      `json:"target_branch"`
+	TargetRepo     RepoInfo    `json:"target_repo"`
+	Repository     Project     `json:"repository"`
+	Project        Project     `json:"project"`
+	Author         User        `json:"author"`
+	UpdatedBy      *User       `json:"updated_by"`
+	Sender         User        `json:"sender"`
+	TargetUser     *User       `json:"target_user"`
+	Enterprise     *Enterprise `json:"enterprise"`
+}
+
+// CommentEventPayload contains the information for Gitee's comment (note) event
+type CommentEventPayload struct {
+	HookName      string       `json:"hook_name"`
+	HookID        int64        `json:"hook_id"`
+	HookURL       string       `json:"hook_url"`
+	Password      string       `json:"password"`
+	Timestamp     string       `json:"timestamp"`
+	Sign          string       `json:"sign"`
+	Action        string       `json:"action"`
+	Comment       Note         `json:"comment"`
+	Repository    Project      `json:"repository"`
+	Project       Project      `json:"project"`
+	Author        User         `json:"author"`
+	Sender        User         `json:"sender"`
+	URL           string       `json:"url"`
+	Note          string       `json:"note"`
+	NoteableType  string       `json:"noteable_type"`
+	NoteableID    int64        `json:"noteable_id"`
+	Title         string       `json:"title"`
+	PerIID        string       `json:"per_iid"`
+	ShortCommitID string       `json:"short_commit_id"`
+	Issue         *Issue       `json:"issue"`
+	PullRequest   *PullRequest `json:"pull_request"`
+	Enterprise    *Enterprise  `json:"enterprise"`
+}
+
+// User contains all of the Gitee user information
+type User struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email"`
+	Username  string     `json:"username"`
+	UserName  string     `json:"user_name"`
+	URL       string     `json:"url"`
+	Login     string     `json:"login"`
+	AvatarURL string     `json:"avatar_url"`
+	HTMLURL   string     `json:"html_url"`
+	Type      string     `json:"type"`
+	SiteAdmin bool       `json:"site_admin"`
+	Time      customTime `json:"time"`
+	Remark    string     `json:"remark"`
+}
+
+// Commit contains all of the Gitee commit information
+type Commit struct {
+	ID        string     `json:"id"`
+	TreeID    string     `json:"tree_id"`
+	ParentIDs []string   `json:"parent_ids"`
+	Distinct  bool       `json:"distinct"`
+	Message   string     `json:"message"`
+	Timestamp customTime `json:"timestamp"`
+	URL       string     `json:"url"`
+	Author    User       `json:"author"`
+	Committer User       `json:"committer"`
+	Added     []string   `json:"added"`
+	Removed   []string   `json:"removed"`
+	Modified  []string   `json:"modified"`
+}
+
+// Project contains all of the Gitee repository information
+type Project struct {
+	ID                int64      `json:"id"`
+	Name              string     `json:"name"`
+	Path              string     `json:"path"`
+	FullName          string     `json:"full_name"`
+	Owner             User       `json:"owner"`
+	Assigner          *User      `json:"assigner"`
+	Private           bool       `json:"private"`
+	Public            bool       `json:"public"`
+	Internal          bool       `json:"internal"`
+	HTMLURL           string     `json:"html_url"`
+	URL               string     `json:"url"`
+	Description       string     `json:"description"`
+	Fork              bool       `json:"fork"`
+	CreatedAt         customTime `json:"created_at"`
+	UpdatedAt         customTime `json:"updated_at"`
+	PushedAt          customTime `json:"pushed_at"`
+	GitURL            string     `json:"git_url"`
+	SSHURL            string     `json:"ssh_url"`
+	CloneURL          string     `json:"clone_url"`
+	SvnURL            string     `json:"svn_url"`
+	GitHTTPURL        string     `json:"git_http_url"`
+	GitSSHURL         string     `json:"git_ssh_url"`
+	GitSvnURL         string     `json:"git_svn_url"`
+	Homepage          string     `json:"homepage"`
+	StargazersCount   int64      `json:"stargazers_count"`
+	WatchersCount     int64      `json:"watchers_count"`
+	ForksCount        int64      `json:"forks_count"`
+	Language          string     `json:"language"`
+	HasIssues         bool       `json:"has_issues"`
+	HasWiki           bool       `json:"has_wiki"`
+	HasPages          bool       `json:"has_pages"`
+	License           string     `json:"license"`
+	OpenIssuesCount   int64      `json:"open_issues_count"`
+	DefaultBranch     string     `json:"default_branch"`
+	Namespace         string     `json:"namespace"`
+	NameWithNamespace string     `json:"name_with_namespace"`
+	PathWithNamespace string     `json:"path_with_namespace"`
+}
+
+// RepoInfo contains the source or target repository of a Gitee pull request
+type RepoInfo struct {
+	Project    Project `json:"project"`
+	Repository Project `json:"repository"`
+}
+
+// Label contains all of the Gitee label information
+type Label struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Milestone contains all of the Gitee milestone information
+type Milestone struct {
+	HTMLURL      string     `json:"html_url"`
+	ID           int64      `json:"id"`
+	Number       int64      `json:"number"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	OpenIssues   int64      `json:"open_issues"`
+	ClosedIssues int64      `json:"closed_issues"`
+	State        string     `json:"state"`
+	CreatedAt    customTime `json:"created_at"`
+	UpdatedAt    customTime `json:"updated_at"`
+	DueOn        customTime `json:"due_on"`
+}
+
+// Issue contains all of the Gitee issue information
+type Issue struct {
+	HTMLURL       string     `json:"html_url"`
+	ID            int64      `json:"id"`
+	Number        string     `json:"number"`
+	Title         string     `json:"title"`
+	User          User       `json:"user"`
+	Labels        []Label    `json:"labels"`
+	State         string     `json:"state"`
+	StateName     string     `json:"state_name"`
+	TypeName      string     `json:"type_name"`
+	Assignee      *User      `json:"assignee"`
+	Collaborators []User     `json:"collaborators"`
+	Milestone     *Milestone `json:"milestone"`
+	Comments      int64      `json:"comments"`
+	CreatedAt     customTime `json:"created_at"`
+	UpdatedAt     customTime `json:"updated_at"`
+	Body          string     `json:"body"`
+}
+
+// Branch contains the head or base branch of a Gitee pull request
+type Branch struct {
+	Label string  `json:"label"`
+	Ref   string  `json:"ref"`
+	SHA   string  `json:"sha"`
+	User  User    `json:"user"`
+	Repo  Project `json:"repo"`
+}
+
+// PullRequest contains all of the Gitee pull request information
+type PullRequest struct {
+	ID                 int64      `json:"id"`
+	Number             int64      `json:"number"`
+	State              string     `json:"state"`
+	HTMLURL            string     `json:"html_url"`
+	DiffURL            string     `json:"diff_url"`
+	PatchURL           string     `json:"patch_url"`
+	Title              string     `json:"title"`
+	Body               string     `json:"body"`
+	Labels             []Label    `json:"labels"`
+	CreatedAt          customTime `json:"created_at"`
+	UpdatedAt          customTime `json:"updated_at"`
+	ClosedAt           customTime `json:"closed_at"`
+	MergedAt           customTime `json:"merged_at"`
+	MergeCommitSHA     string     `json:"merge_commit_sha"`
+	MergeReferenceName string     `json:"merge_reference_name"`
+	User               User       `json:"user"`
+	Assignee           *User      `json:"assignee"`
+	Assignees          []User     `json:"assignees"`
+	Tester             *User      `json:"tester"`
+	Testers            []User     `json:"testers"`
+	NeedTest           bool       `json:"need_test"`
+	NeedReview         bool       `json:"need_review"`
+	Milestone          *Milestone `json:"milestone"`
+	Head               Branch     `json:"head"`
+	Base               Branch     `json:"base"`
+	Merged             bool       `json:"merged"`
+	Mergeable          bool       `json:"mergeable"`
+	MergeStatus        string     `json:"merge_status"`
+	Comments           int64      `json:"comments"`
+	Commits            int64      `json:"commits"`
+	Additions          int64      `json:"additions"`
+	Deletions          int64      `json:"deletions"`
+	ChangedFiles       int64      `json:"changed_files"`
+}
+
+// Note contains all of the Gitee comment information
+type Note struct {
+	HTMLURL   string     `json:"html_url"`
+	ID        int64      `json:"id"`
+	Body      string     `json:"body"`
+	User      User       `json:"user"`
+	CreatedAt customTime `json:"created_at"`
+	UpdatedAt customTime `json:"updated_at"`
+}
+
+// Enterprise contains the Gitee enterprise the repository belongs to
+type Enterprise struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
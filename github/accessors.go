@@ -0,0 +1,63 @@
+package github
+
+// InstallationGetter is implemented by the payloads, returning the GitHub App installation the event was delivered
+// to, nil when the event was not delivered to a GitHub App
+type InstallationGetter interface {
+	GetInstallation() *Installation
+}
+
+// RepositoryGetter is implemented by the payloads, returning the repository of the event, nil when the event
+// does not occur in a repository
+type RepositoryGetter interface {
+	GetRepository() *Repository
+}
+
+// SenderGetter is implemented by the payloads, returning the user who triggered the event, nil when the payload
+// has no sender
+type SenderGetter interface {
+	GetSender() *User
+}
+
+// OrganizationGetter is implemented by the payloads, returning the organization of the event, nil when the event
+// does not occur in an organization
+type OrganizationGetter interface {
+	GetOrganization() *Organization
+}
+
+// EnterpriseGetter is implemented by the payloads, returning the enterprise of the event, nil when the event does
+// not occur in an enterprise
+type EnterpriseGetter interface {
+	GetEnterprise() *Enterprise
+}
+
+// Payload is implemented by every payload returned by Parse, giving the installation, repository, sender,
+// organization and enterprise of the event without a type switch over the events:
+//
+//	payload, err := hook.Parse(r, github.PushEvent, github.PullRequestEvent)
+//	...
+//	if installation := payload.(github.Payload).GetInstallation(); installation != nil {
+//		// authenticate as the installation
+//	}
+type Payload interface {
+	InstallationGetter
+	RepositoryGetter
+	SenderGetter
+	OrganizationGetter
+	EnterpriseGetter
+}
+
+// GetInstallation returns the installation the event is about
+func (pl InstallationPayload) GetInstallation() *Installation {
+	if pl.Installation.ID == 0 {
+		return nil
+	}
+	return &Installation{ID: pl.Installation.ID, NodeID: pl.Installation.NodeID}
+}
+
+// GetInstallation returns the installation the repositories were added to or removed from
+func (pl InstallationRepositoriesPayload) GetInstallation() *Installation {
+	if pl.Installation.ID == 0 {
+		return nil
+	}
+	return &Installation{ID: pl.Installation.ID, NodeID: pl.Installation.NodeID}
+}
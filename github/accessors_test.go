@@ -0,0 +1,130 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadAccessors(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, CheckRunEvent, "../testdata/github/check-run.json").(Payload)
+	assert.Equal(int64(1), pl.GetInstallation().ID)
+	assert.Equal("github/hello-world", pl.GetRepository().FullName)
+	assert.Equal("octocat", pl.GetSender().Login)
+	assert.Equal("github", pl.GetOrganization().Login)
+	assert.Nil(pl.GetEnterprise())
+
+	pl = parseSigned(t, PushEvent, "../testdata/github/push.json").(Payload)
+	assert.Nil(pl.GetInstallation())
+	assert.Nil(pl.GetOrganization())
+	assert.Equal("binkkatal/sample_app", pl.GetRepository().FullName)
+
+	pl = parseSigned(t, InstallationEvent, "../testdata/github/installation.json").(Payload)
+	assert.Equal(int64(2), pl.GetInstallation().ID)
+	assert.Nil(pl.GetRepository())
+
+	pl = parseSigned(t, InstallationTargetEvent, "../testdata/github/installation_target.json").(Payload)
+	assert.Equal(int64(2311213), pl.GetInstallation().ID)
+	assert.Equal("octocat", pl.GetSender().Login)
+
+	pl = parseSigned(t, PingEvent, "../testdata/github/ping.json").(Payload)
+	assert.Nil(pl.GetInstallation())
+	assert.Nil(pl.GetSender())
+}
+
+func TestPayloadImplemented(t *testing.T) {
+	events := []Event{
+		BranchProtectionConfigurationEvent,
+		BranchProtectionRuleEvent,
+		CheckRunEvent,
+		CheckSuiteEvent,
+		CodeScanningAlertEvent,
+		CommitCommentEvent,
+		CreateEvent,
+		CustomPropertyEvent,
+		CustomPropertyValuesEvent,
+		DeleteEvent,
+		DependabotAlertEvent,
+		DeployKeyEvent,
+		DeploymentEvent,
+		DeploymentProtectionRuleEvent,
+		DeploymentReviewEvent,
+		DeploymentStatusEvent,
+		DiscussionEvent,
+		DiscussionCommentEvent,
+		EnterpriseEvent,
+		ForkEvent,
+		GitHubAppAuthorizationEvent,
+		GollumEvent,
+		InstallationEvent,
+		InstallationRepositoriesEvent,
+		InstallationTargetEvent,
+		IntegrationInstallationEvent,
+		IntegrationInstallationRepositoriesEvent,
+		IssueCommentEvent,
+		IssueDependenciesEvent,
+		IssuesEvent,
+		LabelEvent,
+		MarketplacePurchaseEvent,
+		MemberEvent,
+		MembershipEvent,
+		MergeGroupEvent,
+		MetaEvent,
+		MilestoneEvent,
+		OrgBlockEvent,
+		OrganizationEvent,
+		PackageEvent,
+		PageBuildEvent,
+		PersonalAccessTokenRequestEvent,
+		PingEvent,
+		ProjectEvent,
+		ProjectCardEvent,
+		ProjectColumnEvent,
+		ProjectsV2Event,
+		ProjectsV2ItemEvent,
+		ProjectsV2StatusUpdateEvent,
+		PublicEvent,
+		PullRequestEvent,
+		PullRequestReviewEvent,
+		PullRequestReviewCommentEvent,
+		PullRequestReviewThreadEvent,
+		PushEvent,
+		RegistryPackageEvent,
+		ReleaseEvent,
+		RepositoryEvent,
+		RepositoryAdvisoryEvent,
+		RepositoryDispatchEvent,
+		RepositoryImportEvent,
+		RepositoryRulesetEvent,
+		RepositoryVulnerabilityAlertEvent,
+		SecretScanningAlertEvent,
+		SecretScanningAlertLocationEvent,
+		SecretScanningScanEvent,
+		SecurityAdvisoryEvent,
+		SecurityAndAnalysisEvent,
+		SponsorshipEvent,
+		StarEvent,
+		StatusEvent,
+		SubIssuesEvent,
+		TeamEvent,
+		TeamAddEvent,
+		UserEvent,
+		WatchEvent,
+		WorkflowDispatchEvent,
+		WorkflowJobEvent,
+		WorkflowRunEvent,
+	}
+	for _, event := range events {
+		results, err := parsePayload(event, []byte("{}"))
+		require.NoError(t, err, event)
+		pl, ok := results.(Payload)
+		require.True(t, ok, "%s payload does not implement Payload", event)
+		require.Nil(t, pl.GetInstallation(), event)
+		require.Nil(t, pl.GetRepository(), event)
+		require.Nil(t, pl.GetSender(), event)
+		require.Nil(t, pl.GetOrganization(), event)
+		require.Nil(t, pl.GetEnterprise(), event)
+	}
+}
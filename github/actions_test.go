@@ -0,0 +1,77 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseActionFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []Event
+		err    error
+	}{
+		{
+			name:   "Action",
+			events: []Event{PullRequestActionOpened.Event()},
+		},
+		{
+			name:   "ActionString",
+			events: []Event{"pull_request.opened"},
+		},
+		{
+			name:   "AnyAction",
+			events: []Event{PullRequestActionClosed.Event(), PullRequestEvent},
+		},
+		{
+			name:   "OneOfActions",
+			events: []Event{PullRequestActionSynchronize.Event(), PullRequestActionOpened.Event()},
+		},
+		{
+			name:   "OtherAction",
+			events: []Event{PullRequestActionSynchronize.Event(), PullRequestActionReadyForReview.Event()},
+			err:    ErrEventNotFound,
+		},
+		{
+			name:   "OtherEventAction",
+			events: []Event{IssuesActionOpened.Event()},
+			err:    ErrEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			results, err := hook.Parse(signedRequest(t, PullRequestEvent, "../testdata/github/pull-request.json"), tc.events...)
+			if tc.err != nil {
+				assert.Equal(tc.err, err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(PullRequestActionOpened, results.(PullRequestPayload).Action)
+		})
+	}
+}
+
+func TestParseActionFilterMalformed(t *testing.T) {
+	assert := require.New(t)
+
+	req := signedPayloadRequest(PullRequestEvent, []byte(`{"action":1}`))
+	_, err := hook.Parse(req, PullRequestActionOpened.Event())
+	assert.True(errors.Is(err, ErrParsingPayload))
+}
+
+func TestUnknownAction(t *testing.T) {
+	assert := require.New(t)
+
+	var pl PullRequestPayload
+	assert.NoError(json.Unmarshal([]byte(`{"action":"teleported"}`), &pl))
+	assert.Equal(PullRequestAction("teleported"), pl.Action)
+	assert.True(pl.Action == "teleported")
+	assert.Equal(Event("pull_request.teleported"), pl.Action.Event())
+}
@@ -0,0 +1,22 @@
+package github
+
+// TargetsApp reports whether the check run belongs to the GitHub App of the given ID, for ignoring the check runs
+// of other apps
+func (pl CheckRunPayload) TargetsApp(appID int64) bool {
+	return pl.CheckRun.App.ID == appID
+}
+
+// RequestedActionIdentifier returns the identifier of the action requested by the user, empty when the action is
+// not requested_action
+func (pl CheckRunPayload) RequestedActionIdentifier() string {
+	if pl.RequestedAction == nil {
+		return ""
+	}
+	return pl.RequestedAction.Identifier
+}
+
+// TargetsApp reports whether the check suite belongs to the GitHub App of the given ID, for ignoring the check
+// suites of other apps
+func (pl CheckSuitePayload) TargetsApp(appID int64) bool {
+	return pl.CheckSuite.App.ID == appID
+}
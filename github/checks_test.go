@@ -0,0 +1,48 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRunRequestedAction(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, CheckRunEvent, "../testdata/github/check_run_requested_action.json").(CheckRunPayload)
+	assert.Equal(CheckRunActionRequestedAction, pl.Action)
+	assert.Equal("fix_lint", pl.RequestedActionIdentifier())
+	assert.Equal("lint-2", pl.CheckRun.ExternalID)
+	assert.Equal("3 lint errors", pl.CheckRun.Output.Title)
+	assert.True(pl.TargetsApp(29310))
+	assert.False(pl.TargetsApp(15368))
+	assert.Equal("octoapp", pl.CheckRun.App.Slug)
+	assert.Equal("write", pl.CheckRun.App.Permissions["checks"])
+
+	assert.Len(pl.CheckRun.PullRequests, 1)
+	pr := pl.CheckRun.PullRequests[0]
+	assert.Equal(int64(2), pr.Number)
+	assert.Equal("changes", pr.Head.Ref)
+	assert.Equal("ec26c3e57ca3a959ca5aad62de7213c562f8c821", pr.Head.SHA)
+	assert.Equal("master", pr.Base.Ref)
+	assert.Equal(int64(186853002), pr.Base.Repo.ID)
+
+	pl = parseSigned(t, CheckRunEvent, "../testdata/github/check-run.json").(CheckRunPayload)
+	assert.Equal(CheckRunActionRerequested, pl.Action)
+	assert.Empty(pl.RequestedActionIdentifier())
+}
+
+func TestCheckSuiteRerequested(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, CheckSuiteEvent, "../testdata/github/check_suite_rerequested.json").(CheckSuitePayload)
+	assert.Equal(CheckSuiteActionRerequested, pl.Action)
+	assert.True(pl.TargetsApp(29310))
+	assert.False(pl.TargetsApp(0))
+	assert.True(pl.CheckSuite.Rerequestable)
+	assert.Equal("Octocoders", pl.CheckSuite.App.Owner.Login)
+	assert.Equal("https://api.github.com/orgs/Octocoders/hooks", pl.CheckSuite.App.Owner.HooksURL)
+	assert.Len(pl.CheckSuite.PullRequests, 1)
+	assert.Equal(int64(2), pl.CheckSuite.PullRequests[0].Number)
+	assert.Equal(int64(2311213), pl.GetInstallation().ID)
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// deployment review errors
+var (
+	ErrMissingDeploymentCallbackURL = errors.New("missing deployment_callback_url")
+	ErrInvalidDeploymentReviewState = errors.New("deployment review state must be approved or rejected")
+)
+
+// Deployment protection rule review states
+const (
+	DeploymentApproved = "approved"
+	DeploymentRejected = "rejected"
+)
+
+// DeploymentProtectionRuleReview is the body approving or rejecting a deployment
+// through the deployment_callback_url of a deployment_protection_rule event
+type DeploymentProtectionRuleReview struct {
+	EnvironmentName string `json:"environment_name"`
+	State           string `json:"state"`
+	Comment         string `json:"comment,omitempty"`
+}
+
+// NewDeploymentProtectionRuleReview builds the review of the deployment described by pl,
+// state being either DeploymentApproved or DeploymentRejected
+func NewDeploymentProtectionRuleReview(pl DeploymentProtectionRulePayload, state, comment string) (DeploymentProtectionRuleReview, error) {
+	if state != DeploymentApproved && state != DeploymentRejected {
+		return DeploymentProtectionRuleReview{}, ErrInvalidDeploymentReviewState
+	}
+	return DeploymentProtectionRuleReview{
+		EnvironmentName: pl.Environment,
+		State:           state,
+		Comment:         comment,
+	}, nil
+}
+
+// NewDeploymentProtectionRuleReviewRequest builds the request posting the review to the deployment_callback_url,
+// authenticated with the installation access token of the GitHub App owning the protection rule;
+// GitHub answers 204 No Content once the review is recorded
+func NewDeploymentProtectionRuleReviewRequest(pl DeploymentProtectionRulePayload, state, comment, token string) (*http.Request, error) {
+	if len(pl.DeploymentCallbackURL) == 0 {
+		return nil, ErrMissingDeploymentCallbackURL
+	}
+
+	review, err := NewDeploymentProtectionRuleReview(pl, state, comment)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, pl.DeploymentCallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req, nil
+}
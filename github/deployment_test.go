@@ -0,0 +1,74 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentProtectionRuleReviewRequest(t *testing.T) {
+	assert := require.New(t)
+
+	payload, err := os.ReadFile("../testdata/github/deployment_protection_rule.json")
+	assert.NoError(err)
+	var pl DeploymentProtectionRulePayload
+	assert.NoError(json.Unmarshal(payload, &pl))
+
+	var (
+		method string
+		path   string
+		header http.Header
+		review DeploymentProtectionRuleReview
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		header = r.Header
+		assert.NoError(json.NewDecoder(r.Body).Decode(&review))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	pl.DeploymentCallbackURL = server.URL + "/repos/Codertocat/Hello-World/actions/runs/6380135487/deployment_protection_rule"
+
+	req, err := NewDeploymentProtectionRuleReviewRequest(pl, DeploymentApproved, "All checks passed", "ghs_installationToken")
+	assert.NoError(err)
+
+	resp, err := server.Client().Do(req)
+	assert.NoError(err)
+	_ = resp.Body.Close()
+	assert.Equal(http.StatusNoContent, resp.StatusCode)
+
+	assert.Equal(http.MethodPost, method)
+	assert.Equal("/repos/Codertocat/Hello-World/actions/runs/6380135487/deployment_protection_rule", path)
+	assert.Equal("Bearer ghs_installationToken", header.Get("Authorization"))
+	assert.Equal("application/vnd.github+json", header.Get("Accept"))
+	assert.Equal("application/json", header.Get("Content-Type"))
+	assert.Equal(DeploymentProtectionRuleReview{
+		EnvironmentName: "production",
+		State:           DeploymentApproved,
+		Comment:         "All checks passed",
+	}, review)
+}
+
+func TestDeploymentProtectionRuleReviewErrors(t *testing.T) {
+	assert := require.New(t)
+
+	pl := DeploymentProtectionRulePayload{Environment: "production"}
+	_, err := NewDeploymentProtectionRuleReviewRequest(pl, DeploymentRejected, "", "token")
+	assert.Equal(ErrMissingDeploymentCallbackURL, err)
+
+	pl.DeploymentCallbackURL = "https://api.github.com/repos/Codertocat/Hello-World/actions/runs/1/deployment_protection_rule"
+	_, err = NewDeploymentProtectionRuleReviewRequest(pl, "pending", "", "token")
+	assert.Equal(ErrInvalidDeploymentReviewState, err)
+
+	review, err := NewDeploymentProtectionRuleReview(pl, DeploymentRejected, "")
+	assert.NoError(err)
+	body, err := json.Marshal(review)
+	assert.NoError(err)
+	assert.JSONEq(`{"environment_name":"production","state":"rejected"}`, string(body))
+}
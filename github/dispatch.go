@@ -0,0 +1,76 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNoDispatchPayload is returned when decoding a dispatch payload absent from the event
+var ErrNoDispatchPayload = errors.New("event carries no dispatch payload")
+
+// WorkflowDispatchInputs contains the inputs of a workflow_dispatch event, keeping every input for DecodeInputs
+type WorkflowDispatchInputs struct {
+	Name string `json:"name"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON decodes the inputs, keeping them raw for DecodeInputs
+func (in *WorkflowDispatchInputs) UnmarshalJSON(data []byte) error {
+	// alias drops the method set, preventing recursion
+	type alias WorkflowDispatchInputs
+	if err := json.Unmarshal(data, (*alias)(in)); err != nil {
+		return err
+	}
+	in.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// MarshalJSON encodes the inputs as received, so inputs without a field survive a round trip
+func (in WorkflowDispatchInputs) MarshalJSON() ([]byte, error) {
+	if in.raw != nil {
+		return in.raw, nil
+	}
+	type alias WorkflowDispatchInputs
+	return json.Marshal(alias(in))
+}
+
+// DecodeInputs decodes the workflow_dispatch inputs into v, a pointer to a caller defined struct or map
+func (pl WorkflowDispatchPayload) DecodeInputs(v interface{}) error {
+	return decodeDispatch(pl.Inputs.raw, v)
+}
+
+// DecodeClientPayload decodes the repository_dispatch client_payload into v, a pointer to a caller defined struct or map
+func (pl RepositoryDispatchPayload) DecodeClientPayload(v interface{}) error {
+	return decodeDispatch(pl.ClientPayload, v)
+}
+
+// DecodeDispatch decodes the client_payload of a RepositoryDispatchPayload or the inputs of a WorkflowDispatchPayload,
+// as returned by Parse once the signature is verified, into v
+//
+//	payload, err := hook.Parse(r, github.RepositoryDispatchEvent, github.WorkflowDispatchEvent)
+//	...
+//	var release ReleaseRequest
+//	err = github.DecodeDispatch(payload, &release)
+func DecodeDispatch(payload interface{}, v interface{}) error {
+	switch pl := payload.(type) {
+	case RepositoryDispatchPayload:
+		return pl.DecodeClientPayload(v)
+	case *RepositoryDispatchPayload:
+		return pl.DecodeClientPayload(v)
+	case WorkflowDispatchPayload:
+		return pl.DecodeInputs(v)
+	case *WorkflowDispatchPayload:
+		return pl.DecodeInputs(v)
+	default:
+		return fmt.Errorf("%w: %T", ErrNoDispatchPayload, payload)
+	}
+}
+
+func decodeDispatch(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ErrNoDispatchPayload
+	}
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDispatch(t *testing.T) {
+	assert := require.New(t)
+
+	type deployRequest struct {
+		Environment string   `json:"environment"`
+		Version     string   `json:"version"`
+		Services    []string `json:"services"`
+		DryRun      bool     `json:"dry_run"`
+	}
+
+	results := parseSigned(t, RepositoryDispatchEvent, "../testdata/github/repository_dispatch.json")
+	var deploy deployRequest
+	assert.NoError(DecodeDispatch(results, &deploy))
+	assert.Equal(deployRequest{Environment: "staging", Version: "v1.2.0", Services: []string{"api", "worker"}}, deploy)
+
+	pl := results.(RepositoryDispatchPayload)
+	assert.Equal("deploy", pl.Action)
+	var clientPayload map[string]interface{}
+	assert.NoError(pl.DecodeClientPayload(&clientPayload))
+	assert.Equal("staging", clientPayload["environment"])
+
+	results = parseSigned(t, WorkflowDispatchEvent, "../testdata/github/workflow_dispatch.json")
+	var inputs struct {
+		Name string `json:"name"`
+	}
+	assert.NoError(DecodeDispatch(results, &inputs))
+	assert.Equal("workflow_dispatch", inputs.Name)
+	// the legacy Inputs field keeps being filled
+	assert.Equal("workflow_dispatch", results.(WorkflowDispatchPayload).Inputs.Name)
+
+	err := DecodeDispatch(PushPayload{}, &inputs)
+	assert.True(errors.Is(err, ErrNoDispatchPayload))
+
+	err = RepositoryDispatchPayload{}.DecodeClientPayload(&clientPayload)
+	assert.Equal(ErrNoDispatchPayload, err)
+}
+
+func TestWorkflowDispatchInputsRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	in := []byte(`{"inputs":{"name":"x","env":"prod"}}`)
+	var pl WorkflowDispatchPayload
+	assert.NoError(json.Unmarshal(in, &pl))
+	assert.Equal("x", pl.Inputs.Name)
+
+	out, err := json.Marshal(pl.Inputs)
+	assert.NoError(err)
+	assert.JSONEq(`{"name":"x","env":"prod"}`, string(out))
+
+	out, err = json.Marshal(WorkflowDispatchInputs{Name: "y"})
+	assert.NoError(err)
+	assert.JSONEq(`{"name":"y"}`, string(out))
+}
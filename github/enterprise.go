@@ -0,0 +1,140 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidEnterpriseVersion is returned when parsing a malformed GitHub Enterprise Server version
+var ErrInvalidEnterpriseVersion = errors.New("invalid GitHub Enterprise Server version")
+
+// Hook types reported by the ping event
+const (
+	RepositoryHookType   = "Repository"
+	OrganizationHookType = "Organization"
+	AppHookType          = "App"
+	BusinessHookType     = "Business"
+	GlobalHookType       = "Global"
+)
+
+// sha256SignatureVersion is the first GitHub Enterprise Server version sending the X-Hub-Signature-256 header
+var sha256SignatureVersion = EnterpriseVersion{Major: 3}
+
+// Metadata contains the delivery information GitHub sends in the headers of a hook
+type Metadata struct {
+	Event                  Event
+	DeliveryID             string
+	HookID                 string
+	InstallationTargetType string
+	InstallationTargetID   string
+	EnterpriseHost         string
+	EnterpriseVersion      string
+}
+
+// ParseMetadata reads the delivery information from the headers of a hook request
+func ParseMetadata(r *http.Request) Metadata {
+	return Metadata{
+		Event:                  Event(r.Header.Get("X-GitHub-Event")),
+		DeliveryID:             r.Header.Get("X-GitHub-Delivery"),
+		HookID:                 r.Header.Get("X-GitHub-Hook-ID"),
+		InstallationTargetType: r.Header.Get("X-GitHub-Hook-Installation-Target-Type"),
+		InstallationTargetID:   r.Header.Get("X-GitHub-Hook-Installation-Target-ID"),
+		EnterpriseHost:         r.Header.Get("X-GitHub-Enterprise-Host"),
+		EnterpriseVersion:      r.Header.Get("X-GitHub-Enterprise-Version"),
+	}
+}
+
+// IsEnterprise reports whether the hook was sent by a GitHub Enterprise Server
+func (m Metadata) IsEnterprise() bool {
+	return m.EnterpriseHost != "" || m.EnterpriseVersion != ""
+}
+
+// Version parses the GitHub Enterprise Server version of the hook, the zero version when sent by github.com
+func (m Metadata) Version() (EnterpriseVersion, error) {
+	if m.EnterpriseVersion == "" {
+		return EnterpriseVersion{}, nil
+	}
+	return ParseEnterpriseVersion(m.EnterpriseVersion)
+}
+
+// EnterpriseVersion is a GitHub Enterprise Server version, the zero value standing for github.com
+type EnterpriseVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseEnterpriseVersion parses versions such as "3.9", "3.10.4" or "2.22.0.rc1", ignoring what follows the patch number
+func ParseEnterpriseVersion(version string) (EnterpriseVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".", 4)
+	if len(parts) < 2 {
+		return EnterpriseVersion{}, fmt.Errorf("%w: %q", ErrInvalidEnterpriseVersion, version)
+	}
+	var numbers [3]int
+	for i := 0; i < len(parts) && i < len(numbers); i++ {
+		part := parts[i]
+		if i == 2 {
+			// drop pre-release suffixes such as "0-rc1"
+			if end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' }); end > 0 {
+				part = part[:end]
+			}
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return EnterpriseVersion{}, fmt.Errorf("%w: %q", ErrInvalidEnterpriseVersion, version)
+		}
+		numbers[i] = n
+	}
+	return EnterpriseVersion{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// IsZero reports whether the version is unset
+func (v EnterpriseVersion) IsZero() bool {
+	return v == EnterpriseVersion{}
+}
+
+// Compare returns -1, 0 or +1 as v is before, equal to or after o
+func (v EnterpriseVersion) Compare(o EnterpriseVersion) int {
+	switch {
+	case v.Major != o.Major:
+		return compareInt(v.Major, o.Major)
+	case v.Minor != o.Minor:
+		return compareInt(v.Minor, o.Minor)
+	default:
+		return compareInt(v.Patch, o.Patch)
+	}
+}
+
+// Before reports whether v is an earlier version than o
+func (v EnterpriseVersion) Before(o EnterpriseVersion) bool {
+	return v.Compare(o) < 0
+}
+
+// AtLeast reports whether v is the given major.minor version or a later one
+func (v EnterpriseVersion) AtLeast(major, minor int) bool {
+	return !v.Before(EnterpriseVersion{Major: major, Minor: minor})
+}
+
+// String returns the version formatted as major.minor.patch
+func (v EnterpriseVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsGlobal reports whether the ping was sent by a GitHub Enterprise Server global hook
+func (pl PingPayload) IsGlobal() bool {
+	return pl.Hook.Type == GlobalHookType
+}
@@ -0,0 +1,121 @@
+package github
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWithMetadata(t *testing.T) {
+	assert := require.New(t)
+
+	req := signedRequest(t, PingEvent, "../testdata/github/ping_global.json")
+	req.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	req.Header.Set("X-GitHub-Hook-ID", "3")
+	req.Header.Set("X-GitHub-Hook-Installation-Target-Type", "integration")
+	req.Header.Set("X-GitHub-Hook-Installation-Target-ID", "8157")
+	req.Header.Set("X-GitHub-Enterprise-Host", "ghes.example.com")
+	req.Header.Set("X-GitHub-Enterprise-Version", "3.10.4")
+
+	results, meta, err := hook.ParseWithMetadata(req, PingEvent)
+	assert.NoError(err)
+	assert.True(results.(PingPayload).IsGlobal())
+	assert.Equal(Metadata{
+		Event:                  PingEvent,
+		DeliveryID:             "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		HookID:                 "3",
+		InstallationTargetType: "integration",
+		InstallationTargetID:   "8157",
+		EnterpriseHost:         "ghes.example.com",
+		EnterpriseVersion:      "3.10.4",
+	}, meta)
+	assert.True(meta.IsEnterprise())
+	version, err := meta.Version()
+	assert.NoError(err)
+	assert.Equal(EnterpriseVersion{Major: 3, Minor: 10, Patch: 4}, version)
+	assert.True(version.AtLeast(3, 9))
+	assert.False(version.AtLeast(3, 11))
+
+	// metadata is returned along with errors
+	req = signedRequest(t, PingEvent, "../testdata/github/ping_global.json")
+	req.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	_, meta, err = hook.ParseWithMetadata(req, PushEvent)
+	assert.Equal(ErrEventNotFound, err)
+	assert.Equal("72d3162e-cc78-11e3-81ab-4c9367dc0958", meta.DeliveryID)
+	assert.False(meta.IsEnterprise())
+}
+
+func TestParseEnterpriseVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected EnterpriseVersion
+		err      bool
+	}{
+		{version: "3.9", expected: EnterpriseVersion{Major: 3, Minor: 9}},
+		{version: "3.10.4", expected: EnterpriseVersion{Major: 3, Minor: 10, Patch: 4}},
+		{version: "2.22.0-rc1", expected: EnterpriseVersion{Major: 2, Minor: 22}},
+		{version: "2.21.3.rc1", expected: EnterpriseVersion{Major: 2, Minor: 21, Patch: 3}},
+		{version: "3", err: true},
+		{version: "three.nine", err: true},
+		{version: "", err: true},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tc.version, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			version, err := ParseEnterpriseVersion(tc.version)
+			if tc.err {
+				assert.True(errors.Is(err, ErrInvalidEnterpriseVersion))
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tc.expected, version)
+		})
+	}
+}
+
+func TestEnterpriseVersionSignature(t *testing.T) {
+	assert := require.New(t)
+
+	legacy, err := New(Options.Secret(hook.secret), Options.EnterpriseVersion("2.21.3"))
+	assert.NoError(err)
+
+	payload, err := os.ReadFile("../testdata/github/user.json")
+	assert.NoError(err)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+		req.Header.Set("X-GitHub-Event", "user")
+		return req
+	}
+
+	mac := hmac.New(sha1.New, []byte(hook.secret))
+	mac.Write(payload)
+	req := newRequest()
+	req.Header.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+	results, err := legacy.Parse(req, UserEvent)
+	assert.NoError(err)
+	assert.Equal("mona", results.(UserPayload).User.Login)
+
+	// the SHA-1 signature is only accepted from servers predating the SHA-256 one
+	req = newRequest()
+	req.Header.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+	_, err = hook.Parse(req, UserEvent)
+	assert.Equal(ErrMissingHubSignatureHeader, err)
+
+	_, err = legacy.Parse(newRequest(), UserEvent)
+	assert.Equal(ErrMissingHubSHA1Header, err)
+
+	_, err = New(Options.EnterpriseVersion("latest"))
+	assert.Error(err)
+}
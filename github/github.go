@@ -1,12 +1,16 @@
 package github
 
+//go:generate go run ./internal/schemagen
+
 import (
 	"crypto/hmac"
+	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"strings"
@@ -18,6 +22,7 @@ var (
 	ErrInvalidHTTPMethod         = errors.New("invalid HTTP Method")
 	ErrMissingGithubEventHeader  = errors.New("missing X-GitHub-Event Header")
 	ErrMissingHubSignatureHeader = errors.New("missing X-Hub-Signature-256 Header")
+	ErrMissingHubSHA1Header      = errors.New("missing X-Hub-Signature Header")
 	ErrEventNotFound             = errors.New("event not defined to be parsed")
 	ErrParsingPayload            = errors.New("error parsing payload")
 	ErrHMACVerificationFailed    = errors.New("HMAC verification failed")
@@ -26,56 +31,19 @@ var (
 // Event defines a GitHub hook event type
 type Event string
 
-// GitHub hook types
-const (
-	CheckRunEvent                            Event = "check_run"
-	CheckSuiteEvent                          Event = "check_suite"
-	CommitCommentEvent                       Event = "commit_comment"
-	CreateEvent                              Event = "create"
-	DeleteEvent                              Event = "delete"
-	DependabotAlertEvent                     Event = "dependabot_alert"
-	DeployKeyEvent                           Event = "deploy_key"
-	DeploymentEvent                          Event = "deployment"
-	DeploymentStatusEvent                    Event = "deployment_status"
-	ForkEvent                                Event = "fork"
-	GollumEvent                              Event = "gollum"
-	InstallationEvent                        Event = "installation"
-	InstallationRepositoriesEvent            Event = "installation_repositories"
-	IntegrationInstallationEvent             Event = "integration_installation"
-	IntegrationInstallationRepositoriesEvent Event = "integration_installation_repositories"
-	IssueCommentEvent                        Event = "issue_comment"
-	IssuesEvent                              Event = "issues"
-	LabelEvent                               Event = "label"
-	MemberEvent                              Event = "member"
-	MembershipEvent                          Event = "membership"
-	MilestoneEvent                           Event = "milestone"
-	MetaEvent                                Event = "meta"
-	OrganizationEvent                        Event = "organization"
-	OrgBlockEvent                            Event = "org_block"
-	PageBuildEvent                           Event = "page_build"
-	PingEvent                                Event = "ping"
-	ProjectCardEvent                         Event = "project_card"
-	ProjectColumnEvent                       Event = "project_column"
-	ProjectEvent                             Event = "project"
-	PublicEvent                              Event = "public"
-	PullRequestEvent                         Event = "pull_request"
-	PullRequestReviewEvent                   Event = "pull_request_review"
-	PullRequestReviewCommentEvent            Event = "pull_request_review_comment"
-	PushEvent                                Event = "push"
-	ReleaseEvent                             Event = "release"
-	RepositoryEvent                          Event = "repository"
-	RepositoryVulnerabilityAlertEvent        Event = "repository_vulnerability_alert"
-	SecurityAdvisoryEvent                    Event = "security_advisory"
-	StatusEvent                              Event = "status"
-	TeamEvent                                Event = "team"
-	TeamAddEvent                             Event = "team_add"
-	WatchEvent                               Event = "watch"
-	WorkflowDispatchEvent                    Event = "workflow_dispatch"
-	WorkflowJobEvent                         Event = "workflow_job"
-	WorkflowRunEvent                         Event = "workflow_run"
-	GitHubAppAuthorizationEvent              Event = "github_app_authorization"
-	CodeScanningAlertEvent                   Event = "code_scanning_alert"
-)
+// WithAction returns the event restricted to one of its actions, such as "pull_request.opened"; given to Parse,
+// the event is only parsed for that action, other actions returning ErrEventNotFound
+func (e Event) WithAction(action string) Event {
+	return e + "." + Event(action)
+}
+
+// split separates the event name from the action of an event restricted with WithAction
+func (e Event) split() (Event, string) {
+	if i := strings.IndexByte(string(e), '.'); i >= 0 {
+		return e[:i], string(e[i+1:])
+	}
+	return e, ""
+}
 
 // EventSubtype defines a GitHub Hook Event subtype
 type EventSubtype string
@@ -106,9 +74,23 @@ func (WebhookOptions) Secret(secret string) Option {
 	}
 }
 
+// EnterpriseVersion registers the version of the GitHub Enterprise Server sending the hooks, selecting the
+// behaviour of that version; servers before 3.0 only sign hooks with the X-Hub-Signature SHA-1 header
+func (WebhookOptions) EnterpriseVersion(version string) Option {
+	return func(hook *Webhook) error {
+		v, err := ParseEnterpriseVersion(version)
+		if err != nil {
+			return err
+		}
+		hook.enterpriseVersion = v
+		return nil
+	}
+}
+
 // Webhook instance contains all methods needed to process events
 type Webhook struct {
-	secret string
+	secret            string
+	enterpriseVersion EnterpriseVersion
 }
 
 // New creates and returns a WebHook instance denoted by the Provider type
@@ -124,241 +106,106 @@ func New(options ...Option) (*Webhook, error) {
 
 // Parse verifies and parses the events specified and returns the payload object or an error
 func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	payload, _, err := hook.ParseWithMetadata(r, events...)
+	return payload, err
+}
+
+// ParseWithMetadata verifies and parses the events specified and returns the payload object, along with the
+// delivery metadata read from the headers, or an error
+func (hook Webhook) ParseWithMetadata(r *http.Request, events ...Event) (interface{}, Metadata, error) {
 	defer func() {
 		_, _ = io.Copy(io.Discard, r.Body)
 		_ = r.Body.Close()
 	}()
 
+	meta := ParseMetadata(r)
+
 	if len(events) == 0 {
-		return nil, ErrEventNotSpecifiedToParse
+		return nil, meta, ErrEventNotSpecifiedToParse
 	}
 	if r.Method != http.MethodPost {
-		return nil, ErrInvalidHTTPMethod
+		return nil, meta, ErrInvalidHTTPMethod
 	}
 
-	event := r.Header.Get("X-GitHub-Event")
-	if event == "" {
-		return nil, ErrMissingGithubEventHeader
+	if meta.Event == "" {
+		return nil, meta, ErrMissingGithubEventHeader
 	}
-	gitHubEvent := Event(event)
 
+	// events given as event.action only accept the listed actions
 	var found bool
+	var actions []string
 	for _, evt := range events {
-		if evt == gitHubEvent {
+		name, action := evt.split()
+		if name != meta.Event {
+			continue
+		}
+		if action == "" {
 			found = true
 			break
 		}
+		actions = append(actions, action)
 	}
 	// event not defined to be parsed
-	if !found {
-		return nil, ErrEventNotFound
+	if !found && len(actions) == 0 {
+		return nil, meta, ErrEventNotFound
 	}
 
 	payload, err := io.ReadAll(r.Body)
 	if err != nil || len(payload) == 0 {
-		return nil, ErrParsingPayload
+		return nil, meta, ErrParsingPayload
 	}
 
 	// If we have a Secret set, we should check the MAC
 	if len(hook.secret) > 0 {
-		signature := r.Header.Get("X-Hub-Signature-256")
-		if len(signature) == 0 {
-			return nil, ErrMissingHubSignatureHeader
+		if err := hook.verifySignature(r.Header, payload); err != nil {
+			return nil, meta, err
 		}
+	}
 
-		signature = strings.TrimPrefix(signature, "sha256=")
+	if !found {
+		var pl struct {
+			Action string `json:"action"`
+		}
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, meta, fmt.Errorf("%w: %v", ErrParsingPayload, err)
+		}
+		for _, action := range actions {
+			if action == pl.Action {
+				found = true
+				break
+			}
+		}
+		// action not defined to be parsed
+		if !found {
+			return nil, meta, ErrEventNotFound
+		}
+	}
 
-		mac := hmac.New(sha256.New, []byte(hook.secret))
-		_, _ = mac.Write(payload)
-		expectedMAC := hex.EncodeToString(mac.Sum(nil))
+	pl, err := parsePayload(meta.Event, payload)
+	return pl, meta, err
+}
 
-		if !hmac.Equal([]byte(signature), []byte(expectedMAC)) {
-			return nil, ErrHMACVerificationFailed
-		}
+// verifySignature checks the X-Hub-Signature-256 header, or the X-Hub-Signature header on Enterprise Server
+// versions predating the SHA-256 signature
+func (hook Webhook) verifySignature(header http.Header, payload []byte) error {
+	signatureHeader, prefix, newHash, errMissing := "X-Hub-Signature-256", "sha256=", sha256.New, ErrMissingHubSignatureHeader
+	if !hook.enterpriseVersion.IsZero() && hook.enterpriseVersion.Before(sha256SignatureVersion) {
+		signatureHeader, prefix, newHash, errMissing = "X-Hub-Signature", "sha1=", sha1.New, ErrMissingHubSHA1Header
 	}
 
-	switch gitHubEvent {
-	case CheckRunEvent:
-		var pl CheckRunPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case CheckSuiteEvent:
-		var pl CheckSuitePayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case CommitCommentEvent:
-		var pl CommitCommentPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case CreateEvent:
-		var pl CreatePayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case DeployKeyEvent:
-		var pl DeployKeyPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case DeleteEvent:
-		var pl DeletePayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case DependabotAlertEvent:
-		var pl DependabotAlertPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case DeploymentEvent:
-		var pl DeploymentPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case DeploymentStatusEvent:
-		var pl DeploymentStatusPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case ForkEvent:
-		var pl ForkPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case GollumEvent:
-		var pl GollumPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case InstallationEvent, IntegrationInstallationEvent:
-		var pl InstallationPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case InstallationRepositoriesEvent, IntegrationInstallationRepositoriesEvent:
-		var pl InstallationRepositoriesPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case IssueCommentEvent:
-		var pl IssueCommentPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case IssuesEvent:
-		var pl IssuesPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case LabelEvent:
-		var pl LabelPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case MemberEvent:
-		var pl MemberPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case MembershipEvent:
-		var pl MembershipPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case MetaEvent:
-		var pl MetaPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case MilestoneEvent:
-		var pl MilestonePayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case OrganizationEvent:
-		var pl OrganizationPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case OrgBlockEvent:
-		var pl OrgBlockPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PageBuildEvent:
-		var pl PageBuildPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PingEvent:
-		var pl PingPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case ProjectCardEvent:
-		var pl ProjectCardPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case ProjectColumnEvent:
-		var pl ProjectColumnPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case ProjectEvent:
-		var pl ProjectPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PublicEvent:
-		var pl PublicPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PullRequestEvent:
-		var pl PullRequestPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PullRequestReviewEvent:
-		var pl PullRequestReviewPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PullRequestReviewCommentEvent:
-		var pl PullRequestReviewCommentPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case PushEvent:
-		var pl PushPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case ReleaseEvent:
-		var pl ReleasePayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case RepositoryEvent:
-		var pl RepositoryPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case RepositoryVulnerabilityAlertEvent:
-		var pl RepositoryVulnerabilityAlertPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case SecurityAdvisoryEvent:
-		var pl SecurityAdvisoryPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case StatusEvent:
-		var pl StatusPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case TeamEvent:
-		var pl TeamPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case TeamAddEvent:
-		var pl TeamAddPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case WatchEvent:
-		var pl WatchPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case WorkflowDispatchEvent:
-		var pl WorkflowDispatchPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case WorkflowJobEvent:
-		var pl WorkflowJobPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case WorkflowRunEvent:
-		var pl WorkflowRunPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case GitHubAppAuthorizationEvent:
-		var pl GitHubAppAuthorizationPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	case CodeScanningAlertEvent:
-		var pl CodeScanningAlertPayload
-		err = json.Unmarshal([]byte(payload), &pl)
-		return pl, err
-	default:
-		return nil, fmt.Errorf("unknown event %s", gitHubEvent)
+	signature := header.Get(signatureHeader)
+	if len(signature) == 0 {
+		return errMissing
+	}
+
+	signature = strings.TrimPrefix(signature, prefix)
+
+	mac := hmac.New(func() hash.Hash { return newHash() }, []byte(hook.secret))
+	_, _ = mac.Write(payload)
+	expectedMAC := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(signature), []byte(expectedMAC)) {
+		return ErrHMACVerificationFailed
 	}
+	return nil
 }
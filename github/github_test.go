@@ -5,12 +5,17 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -50,6 +55,31 @@ func newServer(handler http.HandlerFunc) *httptest.Server {
 	return httptest.NewServer(mux)
 }
 
+// signedRequest returns a request delivering the payload in filename as event, signed with the hook secret
+func signedRequest(t *testing.T, event Event, filename string) *http.Request {
+	payload, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	return signedPayloadRequest(event, payload)
+}
+
+// signedPayloadRequest returns a request delivering payload as event, signed with the hook secret
+func signedPayloadRequest(event Event, payload []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Github-Event", string(event))
+	mac := hmac.New(sha256.New, []byte(hook.secret))
+	mac.Write(payload)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+// parseSigned parses the payload in filename as a signed delivery of event
+func parseSigned(t *testing.T, event Event, filename string) interface{} {
+	results, err := hook.Parse(signedRequest(t, event, filename), event)
+	require.NoError(t, err)
+	return results
+}
+
 func TestBadRequests(t *testing.T) {
 	assert := require.New(t)
 	tests := []struct {
@@ -150,6 +180,51 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"check_suite"},
 			},
 		},
+		{
+			name:     "CheckRunRequestedActionEvent",
+			event:    CheckRunEvent,
+			typ:      CheckRunPayload{},
+			filename: "../testdata/github/check_run_requested_action.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"check_run"},
+			},
+		},
+		{
+			name:     "CheckSuiteRerequestedEvent",
+			event:    CheckSuiteEvent,
+			typ:      CheckSuitePayload{},
+			filename: "../testdata/github/check_suite_rerequested.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"check_suite"},
+			},
+		},
+		{
+			name:     "BranchProtectionRuleEvent",
+			event:    BranchProtectionRuleEvent,
+			typ:      BranchProtectionRulePayload{},
+			filename: "../testdata/github/branch_protection_rule.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"branch_protection_rule"},
+			},
+		},
+		{
+			name:     "BranchProtectionRuleEditedEvent",
+			event:    BranchProtectionRuleEvent,
+			typ:      BranchProtectionRulePayload{},
+			filename: "../testdata/github/branch_protection_rule_edited.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"branch_protection_rule"},
+			},
+		},
+		{
+			name:     "BranchProtectionConfigurationEvent",
+			event:    BranchProtectionConfigurationEvent,
+			typ:      BranchProtectionConfigurationPayload{},
+			filename: "../testdata/github/branch_protection_configuration.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"branch_protection_configuration"},
+			},
+		},
 		{
 			name:     "CommitCommentEvent",
 			event:    CommitCommentEvent,
@@ -168,6 +243,33 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"create"},
 			},
 		},
+		{
+			name:     "CustomPropertyEvent",
+			event:    CustomPropertyEvent,
+			typ:      CustomPropertyPayload{},
+			filename: "../testdata/github/custom_property.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"custom_property"},
+			},
+		},
+		{
+			name:     "CustomPropertyDeletedEvent",
+			event:    CustomPropertyEvent,
+			typ:      CustomPropertyPayload{},
+			filename: "../testdata/github/custom_property_deleted.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"custom_property"},
+			},
+		},
+		{
+			name:     "CustomPropertyValuesEvent",
+			event:    CustomPropertyValuesEvent,
+			typ:      CustomPropertyValuesPayload{},
+			filename: "../testdata/github/custom_property_values.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"custom_property_values"},
+			},
+		},
 		{
 			name:     "DeleteEvent",
 			event:    DeleteEvent,
@@ -204,6 +306,42 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"deployment"},
 			},
 		},
+		{
+			name:     "DeploymentProtectionRuleEvent",
+			event:    DeploymentProtectionRuleEvent,
+			typ:      DeploymentProtectionRulePayload{},
+			filename: "../testdata/github/deployment_protection_rule.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"deployment_protection_rule"},
+			},
+		},
+		{
+			name:     "DeploymentReviewEvent",
+			event:    DeploymentReviewEvent,
+			typ:      DeploymentReviewPayload{},
+			filename: "../testdata/github/deployment_review.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"deployment_review"},
+			},
+		},
+		{
+			name:     "DeploymentReviewApprovedEvent",
+			event:    DeploymentReviewEvent,
+			typ:      DeploymentReviewPayload{},
+			filename: "../testdata/github/deployment_review_approved.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"deployment_review"},
+			},
+		},
+		{
+			name:     "DeploymentReviewRejectedEvent",
+			event:    DeploymentReviewEvent,
+			typ:      DeploymentReviewPayload{},
+			filename: "../testdata/github/deployment_review_rejected.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"deployment_review"},
+			},
+		},
 		{
 			name:     "DeploymentStatusEvent",
 			event:    DeploymentStatusEvent,
@@ -213,6 +351,69 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"deployment_status"},
 			},
 		},
+		{
+			name:     "DiscussionEvent",
+			event:    DiscussionEvent,
+			typ:      DiscussionPayload{},
+			filename: "../testdata/github/discussion.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion"},
+			},
+		},
+		{
+			name:     "DiscussionAnsweredEvent",
+			event:    DiscussionEvent,
+			typ:      DiscussionPayload{},
+			filename: "../testdata/github/discussion_answered.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion"},
+			},
+		},
+		{
+			name:     "DiscussionCategoryChangedEvent",
+			event:    DiscussionEvent,
+			typ:      DiscussionPayload{},
+			filename: "../testdata/github/discussion_category_changed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion"},
+			},
+		},
+		{
+			name:     "DiscussionLabeledEvent",
+			event:    DiscussionEvent,
+			typ:      DiscussionPayload{},
+			filename: "../testdata/github/discussion_labeled.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion"},
+			},
+		},
+		{
+			name:     "DiscussionTransferredEvent",
+			event:    DiscussionEvent,
+			typ:      DiscussionPayload{},
+			filename: "../testdata/github/discussion_transferred.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion"},
+			},
+		},
+		{
+			name:     "DiscussionCommentEvent",
+			event:    DiscussionCommentEvent,
+			typ:      DiscussionCommentPayload{},
+			filename: "../testdata/github/discussion_comment.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"discussion_comment"},
+			},
+		},
+		{
+			name:     "EnterpriseEvent",
+			event:    EnterpriseEvent,
+			typ:      EnterprisePayload{},
+			filename: "../testdata/github/enterprise.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"enterprise"},
+			},
+		},
 		{
 			name:     "ForkEvent",
 			event:    ForkEvent,
@@ -267,6 +468,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"integration_installation_repositories"},
 			},
 		},
+		{
+			name:     "InstallationTargetEvent",
+			event:    InstallationTargetEvent,
+			typ:      InstallationTargetPayload{},
+			filename: "../testdata/github/installation_target.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"installation_target"},
+			},
+		},
 		{
 			name:     "IssueCommentEvent",
 			event:    IssueCommentEvent,
@@ -276,6 +486,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"issue_comment"},
 			},
 		},
+		{
+			name:     "IssueDependenciesEvent",
+			event:    IssueDependenciesEvent,
+			typ:      IssueDependenciesPayload{},
+			filename: "../testdata/github/issue_dependencies.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"issue_dependencies"},
+			},
+		},
 		{
 			name:     "PullRequestIssueCommentEvent",
 			event:    IssueCommentEvent,
@@ -294,6 +513,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"issues"},
 			},
 		},
+		{
+			name:     "IssuesTypedEvent",
+			event:    IssuesEvent,
+			typ:      IssuesPayload{},
+			filename: "../testdata/github/issues_typed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"issues"},
+			},
+		},
 		{
 			name:     "LabelEvent",
 			event:    LabelEvent,
@@ -303,6 +531,42 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"label"},
 			},
 		},
+		{
+			name:     "MarketplacePurchaseEvent",
+			event:    MarketplacePurchaseEvent,
+			typ:      MarketplacePurchasePayload{},
+			filename: "../testdata/github/marketplace_purchase.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"marketplace_purchase"},
+			},
+		},
+		{
+			name:     "MarketplacePurchaseChangedEvent",
+			event:    MarketplacePurchaseEvent,
+			typ:      MarketplacePurchasePayload{},
+			filename: "../testdata/github/marketplace_purchase_changed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"marketplace_purchase"},
+			},
+		},
+		{
+			name:     "MarketplacePurchasePendingChangeEvent",
+			event:    MarketplacePurchaseEvent,
+			typ:      MarketplacePurchasePayload{},
+			filename: "../testdata/github/marketplace_purchase_pending_change.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"marketplace_purchase"},
+			},
+		},
+		{
+			name:     "MarketplacePurchaseCancelledEvent",
+			event:    MarketplacePurchaseEvent,
+			typ:      MarketplacePurchasePayload{},
+			filename: "../testdata/github/marketplace_purchase_cancelled.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"marketplace_purchase"},
+			},
+		},
 		{
 			name:     "MemberEvent",
 			event:    MemberEvent,
@@ -321,6 +585,24 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"membership"},
 			},
 		},
+		{
+			name:     "MergeGroupEvent",
+			event:    MergeGroupEvent,
+			typ:      MergeGroupPayload{},
+			filename: "../testdata/github/merge_group.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"merge_group"},
+			},
+		},
+		{
+			name:     "MergeGroupDestroyedEvent",
+			event:    MergeGroupEvent,
+			typ:      MergeGroupPayload{},
+			filename: "../testdata/github/merge_group_destroyed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"merge_group"},
+			},
+		},
 		{
 			name:     "MilestoneEvent",
 			event:    MilestoneEvent,
@@ -348,6 +630,24 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"org_block"},
 			},
 		},
+		{
+			name:     "PackageEvent",
+			event:    PackageEvent,
+			typ:      PackagePayload{},
+			filename: "../testdata/github/package.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"package"},
+			},
+		},
+		{
+			name:     "PackageUpdatedEvent",
+			event:    PackageEvent,
+			typ:      PackagePayload{},
+			filename: "../testdata/github/package_updated.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"package"},
+			},
+		},
 		{
 			name:     "PageBuildEvent",
 			event:    PageBuildEvent,
@@ -357,6 +657,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"page_build"},
 			},
 		},
+		{
+			name:     "PersonalAccessTokenRequestEvent",
+			event:    PersonalAccessTokenRequestEvent,
+			typ:      PersonalAccessTokenRequestPayload{},
+			filename: "../testdata/github/personal_access_token_request.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"personal_access_token_request"},
+			},
+		},
 		{
 			name:     "PingEvent",
 			event:    PingEvent,
@@ -366,6 +675,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"ping"},
 			},
 		},
+		{
+			name:     "PingGlobalEvent",
+			event:    PingEvent,
+			typ:      PingPayload{},
+			filename: "../testdata/github/ping_global.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"ping"},
+			},
+		},
 		{
 			name:     "ProjectCardEvent",
 			event:    ProjectCardEvent,
@@ -393,6 +711,87 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"project"},
 			},
 		},
+		{
+			name:     "ProjectsV2Event",
+			event:    ProjectsV2Event,
+			typ:      ProjectsV2Payload{},
+			filename: "../testdata/github/projects_v2.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2"},
+			},
+		},
+		{
+			name:     "ProjectsV2ClosedEvent",
+			event:    ProjectsV2Event,
+			typ:      ProjectsV2Payload{},
+			filename: "../testdata/github/projects_v2_closed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemSingleSelectEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item_single_select.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemIterationEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item_iteration.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemDateEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item_date.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemTextEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item_text.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2ItemArchivedEvent",
+			event:    ProjectsV2ItemEvent,
+			typ:      ProjectsV2ItemPayload{},
+			filename: "../testdata/github/projects_v2_item_archived.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_item"},
+			},
+		},
+		{
+			name:     "ProjectsV2StatusUpdateEvent",
+			event:    ProjectsV2StatusUpdateEvent,
+			typ:      ProjectsV2StatusUpdatePayload{},
+			filename: "../testdata/github/projects_v2_status_update.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"projects_v2_status_update"},
+			},
+		},
 		{
 			name:     "PublicEvent",
 			event:    PublicEvent,
@@ -429,6 +828,24 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"pull_request_review_comment"},
 			},
 		},
+		{
+			name:     "PullRequestReviewThreadEvent",
+			event:    PullRequestReviewThreadEvent,
+			typ:      PullRequestReviewThreadPayload{},
+			filename: "../testdata/github/pull_request_review_thread.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"pull_request_review_thread"},
+			},
+		},
+		{
+			name:     "PullRequestReviewThreadUnresolvedEvent",
+			event:    PullRequestReviewThreadEvent,
+			typ:      PullRequestReviewThreadPayload{},
+			filename: "../testdata/github/pull_request_review_thread_unresolved.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"pull_request_review_thread"},
+			},
+		},
 		{
 			name:     "PushEvent",
 			event:    PushEvent,
@@ -456,6 +873,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"repository"},
 			},
 		},
+		{
+			name:     "RepositoryAnonymousAccessEvent",
+			event:    RepositoryEvent,
+			typ:      RepositoryPayload{},
+			filename: "../testdata/github/repository_anonymous_access.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository"},
+			},
+		},
 		{
 			name:     "RepositoryEditedEvent",
 			event:    RepositoryEvent,
@@ -465,6 +891,42 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"repository"},
 			},
 		},
+		{
+			name:     "RepositoryDispatchEvent",
+			event:    RepositoryDispatchEvent,
+			typ:      RepositoryDispatchPayload{},
+			filename: "../testdata/github/repository_dispatch.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_dispatch"},
+			},
+		},
+		{
+			name:     "RepositoryImportEvent",
+			event:    RepositoryImportEvent,
+			typ:      RepositoryImportPayload{},
+			filename: "../testdata/github/repository_import.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_import"},
+			},
+		},
+		{
+			name:     "RepositoryRulesetEvent",
+			event:    RepositoryRulesetEvent,
+			typ:      RepositoryRulesetPayload{},
+			filename: "../testdata/github/repository_ruleset.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_ruleset"},
+			},
+		},
+		{
+			name:     "RepositoryRulesetEditedEvent",
+			event:    RepositoryRulesetEvent,
+			typ:      RepositoryRulesetPayload{},
+			filename: "../testdata/github/repository_ruleset_edited.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_ruleset"},
+			},
+		},
 		{
 			name:     "RepositoryVulnerabilityAlertEvent",
 			event:    RepositoryVulnerabilityAlertEvent,
@@ -474,6 +936,87 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"repository_vulnerability_alert"},
 			},
 		},
+		{
+			name:     "RegistryPackageEvent",
+			event:    RegistryPackageEvent,
+			typ:      RegistryPackagePayload{},
+			filename: "../testdata/github/registry_package.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"registry_package"},
+			},
+		},
+		{
+			name:     "RegistryPackageUpdatedEvent",
+			event:    RegistryPackageEvent,
+			typ:      RegistryPackagePayload{},
+			filename: "../testdata/github/registry_package_updated.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"registry_package"},
+			},
+		},
+		{
+			name:     "RepositoryAdvisoryEvent",
+			event:    RepositoryAdvisoryEvent,
+			typ:      RepositoryAdvisoryPayload{},
+			filename: "../testdata/github/repository_advisory.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_advisory"},
+			},
+		},
+		{
+			name:     "RepositoryAdvisoryReportedEvent",
+			event:    RepositoryAdvisoryEvent,
+			typ:      RepositoryAdvisoryPayload{},
+			filename: "../testdata/github/repository_advisory_reported.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"repository_advisory"},
+			},
+		},
+		{
+			name:     "SecretScanningAlertEvent",
+			event:    SecretScanningAlertEvent,
+			typ:      SecretScanningAlertPayload{},
+			filename: "../testdata/github/secret_scanning_alert.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"secret_scanning_alert"},
+			},
+		},
+		{
+			name:     "SecretScanningAlertResolvedEvent",
+			event:    SecretScanningAlertEvent,
+			typ:      SecretScanningAlertPayload{},
+			filename: "../testdata/github/secret_scanning_alert_resolved.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"secret_scanning_alert"},
+			},
+		},
+		{
+			name:     "SecretScanningAlertAssignedEvent",
+			event:    SecretScanningAlertEvent,
+			typ:      SecretScanningAlertPayload{},
+			filename: "../testdata/github/secret_scanning_alert_assigned.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"secret_scanning_alert"},
+			},
+		},
+		{
+			name:     "SecretScanningAlertLocationEvent",
+			event:    SecretScanningAlertLocationEvent,
+			typ:      SecretScanningAlertLocationPayload{},
+			filename: "../testdata/github/secret_scanning_alert_location.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"secret_scanning_alert_location"},
+			},
+		},
+		{
+			name:     "SecretScanningScanEvent",
+			event:    SecretScanningScanEvent,
+			typ:      SecretScanningScanPayload{},
+			filename: "../testdata/github/secret_scanning_scan.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"secret_scanning_scan"},
+			},
+		},
 		{
 			name:     "SecurityAdvisoryEvent",
 			event:    SecurityAdvisoryEvent,
@@ -483,6 +1026,60 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"security_advisory"},
 			},
 		},
+		{
+			name:     "SecurityAndAnalysisEvent",
+			event:    SecurityAndAnalysisEvent,
+			typ:      SecurityAndAnalysisPayload{},
+			filename: "../testdata/github/security_and_analysis.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"security_and_analysis"},
+			},
+		},
+		{
+			name:     "SponsorshipEvent",
+			event:    SponsorshipEvent,
+			typ:      SponsorshipPayload{},
+			filename: "../testdata/github/sponsorship.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"sponsorship"},
+			},
+		},
+		{
+			name:     "SponsorshipTierChangedEvent",
+			event:    SponsorshipEvent,
+			typ:      SponsorshipPayload{},
+			filename: "../testdata/github/sponsorship_tier_changed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"sponsorship"},
+			},
+		},
+		{
+			name:     "SponsorshipPendingCancellationEvent",
+			event:    SponsorshipEvent,
+			typ:      SponsorshipPayload{},
+			filename: "../testdata/github/sponsorship_pending_cancellation.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"sponsorship"},
+			},
+		},
+		{
+			name:     "StarEvent",
+			event:    StarEvent,
+			typ:      StarPayload{},
+			filename: "../testdata/github/star.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"star"},
+			},
+		},
+		{
+			name:     "StarDeletedEvent",
+			event:    StarEvent,
+			typ:      StarPayload{},
+			filename: "../testdata/github/star_deleted.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"star"},
+			},
+		},
 		{
 			name:     "StatusEvent",
 			event:    StatusEvent,
@@ -492,6 +1089,24 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"status"},
 			},
 		},
+		{
+			name:     "SubIssuesEvent",
+			event:    SubIssuesEvent,
+			typ:      SubIssuesPayload{},
+			filename: "../testdata/github/sub_issues.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"sub_issues"},
+			},
+		},
+		{
+			name:     "SubIssuesParentIssueRemovedEvent",
+			event:    SubIssuesEvent,
+			typ:      SubIssuesPayload{},
+			filename: "../testdata/github/sub_issues_parent_issue_removed.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"sub_issues"},
+			},
+		},
 		{
 			name:     "TeamEvent",
 			event:    TeamEvent,
@@ -510,6 +1125,15 @@ func TestWebhooks(t *testing.T) {
 				"X-Github-Event": []string{"team_add"},
 			},
 		},
+		{
+			name:     "UserEvent",
+			event:    UserEvent,
+			typ:      UserPayload{},
+			filename: "../testdata/github/user.json",
+			headers: http.Header{
+				"X-Github-Event": []string{"user"},
+			},
+		},
 		{
 			name:     "WatchEvent",
 			event:    WatchEvent,
@@ -566,6 +1190,8 @@ func TestWebhooks(t *testing.T) {
 		},
 	}
 
+	actions := actionConstants(t)
+
 	for _, tt := range tests {
 		tc := tt
 		client := &http.Client{}
@@ -596,6 +1222,76 @@ func TestWebhooks(t *testing.T) {
 			assert.Equal(http.StatusOK, resp.StatusCode)
 			assert.NoError(parseError)
 			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+
+			// every recorded action must have a constant of the action type
+			action := reflect.ValueOf(results).FieldByName("Action")
+			if action.IsValid() && action.Type().Name() != "string" && action.String() != "" {
+				assert.Contains(actions[action.Type().Name()], action.String(), "no %s constant", action.Type().Name())
+			}
 		})
 	}
 }
+
+// actionConstants returns the values of the action constants of payload_gen.go by action type
+func actionConstants(t *testing.T) map[string][]string {
+	file, err := parser.ParseFile(token.NewFileSet(), "payload_gen.go", nil, 0)
+	require.NoError(t, err)
+	constants := make(map[string][]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			typ, ok := value.Type.(*ast.Ident)
+			if !ok || !strings.HasSuffix(typ.Name, "Action") || len(value.Values) != 1 {
+				continue
+			}
+			if lit, ok := value.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				v, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+				constants[typ.Name] = append(constants[typ.Name], v)
+			}
+		}
+	}
+	return constants
+}
+
+func TestSharedTypes(t *testing.T) {
+	assert := require.New(t)
+
+	fullName := func(repo Repository) string { return repo.FullName }
+	login := func(user User) string { return user.Login }
+
+	pr := parseSigned(t, PullRequestEvent, "../testdata/github/pull-request.json").(PullRequestPayload)
+	assert.Equal("baxterthehacker/public-repo", fullName(pr.Repository))
+	assert.Equal("baxterthehacker/public-repo", fullName(pr.PullRequest.Head.Repo))
+	assert.Equal("baxterthehacker", login(pr.Sender))
+
+	review := parseSigned(t, PullRequestReviewEvent, "../testdata/github/pull-request-review.json").(PullRequestReviewPayload)
+	var pullRequest PullRequest = review.PullRequest
+	assert.Equal(review.PullRequest.Number, pullRequest.Number)
+
+	push := parseSigned(t, PushEvent, "../testdata/github/push.json").(PushPayload)
+	assert.Equal("binkkatal", login(push.Sender))
+	assert.Equal(2018, push.HeadCommit.Timestamp.Year())
+
+	suite := parseSigned(t, CheckSuiteEvent, "../testdata/github/check-suite.json").(CheckSuitePayload)
+	assert.Equal("octocat", suite.CheckSuite.HeadCommit.Committer.Name)
+
+	app := parseSigned(t, GitHubAppAuthorizationEvent, "../testdata/github/github-app-authorization.json").(GitHubAppAuthorizationPayload)
+	assert.Equal("https://api.github.com/users/pansachin/orgs", app.Sender.OrganizationsURL)
+
+	comment := parseSigned(t, PullRequestReviewCommentEvent, "../testdata/github/pull-request-review-comment.json").(PullRequestReviewCommentPayload)
+	thread := parseSigned(t, PullRequestReviewThreadEvent, "../testdata/github/pull_request_review_thread.json").(PullRequestReviewThreadPayload)
+	links := comment.Comment.Links
+	assert.Equal(links, thread.Thread.Comments[0].Links)
+	links = thread.Thread.Comments[0].Links
+	assert.Equal("https://api.github.com/repos/baxterthehacker/public-repo/pulls/1", links.PullRequest.Href)
+
+	reactions := func(r Reactions) string { return r.URL }
+	discussion := parseSigned(t, DiscussionCommentEvent, "../testdata/github/discussion_comment.json").(DiscussionCommentPayload)
+	assert.Equal("https://api.github.com/repos/Codertocat/Hello-World/discussions/90/reactions", reactions(discussion.Discussion.Reactions))
+	assert.Equal("https://api.github.com/repos/Codertocat/Hello-World/discussions/comments/7200141/reactions", reactions(discussion.Comment.Reactions))
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// getters are the accessors of the Payload interface, generated from the payload field of the same name
+var getters = []struct {
+	field  string
+	method string
+	typ    string
+}{
+	{field: "Installation", method: "GetInstallation", typ: "Installation"},
+	{field: "Repository", method: "GetRepository", typ: "Repository"},
+	{field: "Sender", method: "GetSender", typ: "User"},
+	{field: "Organization", method: "GetOrganization", typ: "Organization"},
+	{field: "Enterprise", method: "GetEnterprise", typ: "Enterprise"},
+}
+
+// declarations are the payload structs and methods of the github package
+type declarations struct {
+	structs map[string]*ast.StructType
+	methods map[string]bool
+}
+
+// parsePackage reads the declarations of the github package in dir, using the payloads being generated in place
+// of the generated files on disk
+func parsePackage(dir string, payloads []byte) (declarations, error) {
+	decls := declarations{structs: make(map[string]*ast.StructType), methods: make(map[string]bool)}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return decls, err
+	}
+	fset := token.NewFileSet()
+	sources := map[string]interface{}{"payload_gen.go": payloads}
+	for _, file := range files {
+		name := filepath.Base(file)
+		if strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_gen.go") {
+			continue
+		}
+		sources[file] = nil
+	}
+
+	for file, src := range sources {
+		f, err := parser.ParseFile(fset, file, src, 0)
+		if err != nil {
+			return decls, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := spec.Type.(*ast.StructType); ok {
+							decls.structs[spec.Name.Name] = st
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) != 1 {
+					continue
+				}
+				recv := decl.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if ident, ok := recv.(*ast.Ident); ok {
+					decls.methods[ident.Name+"."+decl.Name.Name] = true
+				}
+			}
+		}
+	}
+	return decls, nil
+}
+
+// accessors generates the methods of the Payload interface not written by hand
+func (g *generator) accessors(events []event, pkg string, payloads []byte) ([]byte, error) {
+	decls, err := parsePackage(pkg, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, e := range events {
+		payload := goName(e.name) + "Payload"
+		st, ok := decls.structs[payload]
+		if !ok {
+			return nil, fmt.Errorf("%s: %s is not declared", e.name, payload)
+		}
+
+		for _, getter := range getters {
+			if decls.methods[payload+"."+getter.method] {
+				continue
+			}
+			noun := strings.ToLower(getter.field)
+			buf.WriteString("\n")
+
+			field := lookupField(st, getter.field)
+			if field == nil {
+				fmt.Fprintf(&buf, "// %s returns nil, the %s event having no %s\n", getter.method, e.name, noun)
+				fmt.Fprintf(&buf, "func (pl %s) %s() *%s {\n\treturn nil\n}\n", payload, getter.method, getter.typ)
+				continue
+			}
+
+			fmt.Fprintf(&buf, "// %s returns the %s of the event, nil when the payload has none\n", getter.method, noun)
+			fmt.Fprintf(&buf, "func (pl %s) %s() *%s {\n", payload, getter.method, getter.typ)
+			switch typ := field.(type) {
+			case *ast.Ident:
+				if typ.Name != getter.typ {
+					return nil, fieldError(e.name, getter.field, getter.method)
+				}
+				fmt.Fprintf(&buf, "\tif pl.%s.ID == 0 {\n\t\treturn nil\n\t}\n\treturn &pl.%[1]s\n}\n", getter.field)
+			case *ast.StarExpr:
+				if ident, ok := typ.X.(*ast.Ident); !ok || ident.Name != getter.typ {
+					return nil, fieldError(e.name, getter.field, getter.method)
+				}
+				fmt.Fprintf(&buf, "\treturn pl.%s\n}\n", getter.field)
+			default:
+				return nil, fieldError(e.name, getter.field, getter.method)
+			}
+		}
+	}
+	return format.Source(buf.Bytes())
+}
+
+func lookupField(st *ast.StructType, name string) ast.Expr {
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
+
+func fieldError(event, field, method string) error {
+	return fmt.Errorf("%s: %s is not a shared type, write %s by hand", event, field, method)
+}
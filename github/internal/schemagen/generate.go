@@ -0,0 +1,480 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const header = "// Code generated by schemagen from the webhook schemas. DO NOT EDIT.\n\npackage github\n"
+
+// initialisms are the words of the JSON names written in upper case in the Go names
+var initialisms = map[string]string{
+	"api":    "API",
+	"github": "GitHub",
+	"html":   "HTML",
+	"http":   "HTTP",
+	"https":  "HTTPS",
+	"id":     "ID",
+	"ids":    "IDs",
+	"ip":     "IP",
+	"json":   "JSON",
+	"sha":    "SHA",
+	"ssh":    "SSH",
+	"uri":    "URI",
+	"url":    "URL",
+	"urls":   "URLs",
+}
+
+// packages are the packages the type overrides can refer to, by qualifier
+var packages = map[string]string{
+	"json": "encoding/json",
+}
+
+type generator struct {
+	dir      string
+	manifest manifest
+	schemas  map[string]*schema
+
+	// imports are the packages of the type overrides of the file being generated
+	imports map[string]bool
+
+	// used records the overrides applied, by key and path
+	used map[string]bool
+}
+
+type event struct {
+	name    string
+	aliases []string
+	schema  *schema
+}
+
+// newGenerator returns a generator of the schemas in dir, reading their manifest
+func newGenerator(dir string) (*generator, error) {
+	g := &generator{dir: dir, schemas: make(map[string]*schema), imports: make(map[string]bool), used: make(map[string]bool)}
+
+	data, err := os.ReadFile(filepath.Join(dir, "events.json"))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &g.manifest); err != nil {
+		return nil, fmt.Errorf("events.json: %w", err)
+	}
+	return g, nil
+}
+
+// generate returns the generated files of the github package in pkg, by name, from the schemas in dir
+func generate(dir, pkg string) (map[string][]byte, error) {
+	g, err := newGenerator(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var events []event
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == "common" {
+			continue
+		}
+		s, err := readEvent(dir, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		g.override(entry.Name(), s)
+		events = append(events, event{name: entry.Name(), aliases: g.manifest.Aliases[entry.Name()], schema: s})
+	}
+	for name := range g.manifest.Aliases {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			return nil, fmt.Errorf("events.json: aliases of %s: %w", name, err)
+		}
+	}
+
+	files := make(map[string][]byte)
+	if files["events_gen.go"], err = g.events(events); err != nil {
+		return nil, err
+	}
+	if files["payload_gen.go"], err = g.payloads(events); err != nil {
+		return nil, err
+	}
+	if files["types_gen.go"], err = g.types(); err != nil {
+		return nil, err
+	}
+	if files["accessors_gen.go"], err = g.accessors(events, pkg, files["payload_gen.go"]); err != nil {
+		return nil, err
+	}
+
+	// an override left unused after updating the schemas would silently change the API
+	keys := make([]string, 0, len(g.manifest.Overrides))
+	for key := range g.manifest.Overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for path := range g.manifest.Overrides[key] {
+			if !g.used[key+" "+path] {
+				return nil, fmt.Errorf("events.json: override of %s %s matches no property", key, path)
+			}
+		}
+	}
+	return files, nil
+}
+
+// override applies the overrides of the manifest for key, an event or a common schema, to its schema
+func (g *generator) override(key string, s *schema) {
+	overrides := g.manifest.Overrides[key]
+	if len(overrides) == 0 {
+		return
+	}
+	s.walk("", func(path string, p *schema) {
+		if o, ok := overrides[path]; ok {
+			p.GoName, p.GoType = o.Name, o.Type
+			g.used[key+" "+path] = true
+		}
+	})
+}
+
+// events generates the Event constants and the parsePayload switch
+func (g *generator) events(events []event) ([]byte, error) {
+	var names []string
+	for _, e := range events {
+		names = append(names, e.name)
+		names = append(names, e.aliases...)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("\nimport (\n\t\"encoding/json\"\n\t\"fmt\"\n)\n")
+	buf.WriteString("\n// GitHub hook types\nconst (\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%sEvent Event = %q\n", goName(name), name)
+	}
+	buf.WriteString(")\n")
+
+	buf.WriteString("\nfunc parsePayload(gitHubEvent Event, payload []byte) (interface{}, error) {\n")
+	buf.WriteString("\tvar err error\n\tswitch gitHubEvent {\n")
+	for _, e := range events {
+		cases := []string{goName(e.name) + "Event"}
+		for _, alias := range e.aliases {
+			cases = append(cases, goName(alias)+"Event")
+		}
+		fmt.Fprintf(&buf, "\tcase %s:\n", strings.Join(cases, ", "))
+		fmt.Fprintf(&buf, "\t\tvar pl %sPayload\n", goName(e.name))
+		buf.WriteString("\t\terr = json.Unmarshal([]byte(payload), &pl)\n\t\treturn pl, err\n")
+	}
+	buf.WriteString("\tdefault:\n\t\treturn nil, fmt.Errorf(\"unknown event %s\", gitHubEvent)\n\t}\n}\n")
+	return format.Source(buf.Bytes())
+}
+
+// payloads generates the payload structs of the events and their action types, from the enum of their action
+func (g *generator) payloads(events []event) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, e := range events {
+		name := goName(e.name)
+		fmt.Fprintf(&buf, "\n// %sPayload contains the information for GitHub's %s hook event\n", name, e.name)
+		fmt.Fprintf(&buf, "type %sPayload ", name)
+		if err := g.object(&buf, e.schema, name); err != nil {
+			return nil, fmt.Errorf("%s: %w", e.name, err)
+		}
+		buf.WriteString("\n")
+
+		action := e.schema.property("action")
+		if action == nil || len(action.Enum) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "\n// %sAction is the action of the %s event\ntype %[1]sAction string\n", name, e.name)
+		fmt.Fprintf(&buf, "\n// %s actions\nconst (\n", e.name)
+		for _, value := range unique(action.Enum) {
+			fmt.Fprintf(&buf, "\t%[1]sAction%[2]s %[1]sAction = %[3]q\n", name, goName(value), value)
+		}
+		buf.WriteString(")\n")
+		fmt.Fprintf(&buf, "\n// Event returns the %s event restricted to the action, for filtering it in Parse\n", e.name)
+		fmt.Fprintf(&buf, "func (a %sAction) Event() Event {\n\treturn %[1]sEvent.WithAction(string(a))\n}\n", name)
+	}
+
+	return g.source(buf.Bytes())
+}
+
+// types generates the shared types of the common schemas, except those written by hand
+func (g *generator) types() ([]byte, error) {
+	byName := make(map[string]string, len(g.manifest.Types))
+	var names []string
+	for file, name := range g.manifest.Types {
+		byName[name] = file
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	handwritten := make(map[string]bool, len(g.manifest.Handwritten))
+	for _, name := range g.manifest.Handwritten {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("events.json: handwritten type %s has no schema", name)
+		}
+		handwritten[name] = true
+	}
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		if handwritten[name] {
+			continue
+		}
+		s, err := g.read(filepath.Join(g.dir, filepath.FromSlash(byName[name])))
+		if err != nil {
+			return nil, err
+		}
+		if s.kind() != "object" {
+			return nil, fmt.Errorf("%s: %s is not an object", byName[name], name)
+		}
+		buf.WriteString("\n")
+		buf.WriteString(comment(name, s))
+		fmt.Fprintf(&buf, "type %s ", name)
+		if err := g.object(&buf, s, ""); err != nil {
+			return nil, fmt.Errorf("%s: %w", byName[name], err)
+		}
+		buf.WriteString("\n")
+	}
+	return g.source(buf.Bytes())
+}
+
+// source formats a generated file, importing the packages of its type overrides
+func (g *generator) source(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	var imports []string
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	for _, path := range imports {
+		fmt.Fprintf(&buf, "\nimport %q\n", path)
+	}
+	buf.Write(src)
+	g.imports = make(map[string]bool)
+	return format.Source(buf.Bytes())
+}
+
+// comment returns the doc comment of a shared type from the description of its schema, or its title
+func comment(name string, s *schema) string {
+	description := s.Description
+	switch {
+	case description == "" && s.Title != "":
+		description = fmt.Sprintf("%s contains the %s information of the payloads", name, strings.ToLower(s.Title))
+	case description == "":
+		description = fmt.Sprintf("%s contains the information of %s", name, filepath.Base(s.file))
+	case !strings.HasPrefix(description, name+" "):
+		description = name + " is " + strings.ToLower(description[:1]) + description[1:]
+	}
+	return commentLines(description)
+}
+
+// commentLines returns text as comment lines, wrapping the lines longer than the line length of the package
+func commentLines(text string) string {
+	const width = 112
+	var b strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		var current string
+		for _, word := range strings.Fields(line) {
+			if current != "" && len(current)+1+len(word) > width {
+				b.WriteString("// " + current + "\n")
+				current = ""
+			}
+			if current != "" {
+				current += " "
+			}
+			current += word
+		}
+		if current != "" {
+			b.WriteString("// " + current + "\n")
+		}
+	}
+	return b.String()
+}
+
+// object writes the struct of an object schema; event is set for the payload itself, typing its action. Fields
+// are omitempty when not required.
+func (g *generator) object(buf *bytes.Buffer, s *schema, event string) error {
+	buf.WriteString("struct {\n")
+	for _, p := range s.Properties {
+		var typ string
+		if p.Name == "action" && event != "" && len(p.Schema.Enum) > 0 {
+			typ = event + "Action"
+		} else {
+			var err error
+			if typ, err = g.goType(p.Schema, false); err != nil {
+				return fmt.Errorf("%s: %w", p.Name, err)
+			}
+		}
+		tag := p.Name
+		if !s.required(p.Name) {
+			tag += ",omitempty"
+		}
+		name := p.Schema.GoName
+		if name == "" {
+			name = goName(p.Name)
+		}
+		buf.WriteString(commentLines(p.Schema.Description))
+		fmt.Fprintf(buf, "%s %s `json:%q`\n", name, typ, tag)
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+// goType returns the Go type of a schema, a pointer when nullable; nullable is set for the variants of a
+// nullable oneOf or anyOf and for the referenced schemas of a nullable reference
+func (g *generator) goType(s *schema, nullable bool) (string, error) {
+	nullable = nullable || s.nullable()
+	pointer := func(typ string) string {
+		if nullable {
+			return "*" + typ
+		}
+		return typ
+	}
+
+	if s.GoType != "" {
+		if i := strings.LastIndexByte(s.GoType, '.'); i >= 0 {
+			qualifier := strings.TrimLeft(s.GoType[:i], "[]*")
+			path, ok := packages[qualifier]
+			if !ok {
+				return "", fmt.Errorf("type override %s: unknown package %s", s.GoType, qualifier)
+			}
+			g.imports[path] = true
+		}
+		// the override is the whole type, nullable or not
+		return s.GoType, nil
+	}
+
+	if s.Ref != "" {
+		file := filepath.Join(filepath.Dir(s.file), filepath.FromSlash(s.Ref))
+		rel, err := filepath.Rel(g.dir, file)
+		if err != nil {
+			return "", err
+		}
+		if name, ok := g.manifest.Types[filepath.ToSlash(rel)]; ok {
+			return pointer(name), nil
+		}
+		ref, err := g.read(file)
+		if err != nil {
+			return "", err
+		}
+		return g.goType(ref, nullable)
+	}
+
+	var variants []*schema
+	for _, variant := range append(append([]*schema{}, s.OneOf...), s.AnyOf...) {
+		if len(variant.Type) != 1 || variant.Type[0] != "null" {
+			variants = append(variants, variant)
+		}
+	}
+	switch {
+	case len(variants) == 1:
+		return g.goType(variants[0], nullable)
+	case len(variants) > 1:
+		return "interface{}", nil
+	case len(s.AllOf) > 0:
+		var merged *schema
+		for _, variant := range s.AllOf {
+			merged = merge(merged, variant)
+		}
+		return g.goType(merged, nullable)
+	}
+
+	switch s.kind() {
+	case "string":
+		if s.Format == "date-time" {
+			return pointer("Timestamp"), nil
+		}
+		return pointer("string"), nil
+	case "integer":
+		return pointer("int64"), nil
+	case "number":
+		return pointer("float64"), nil
+	case "boolean":
+		return pointer("bool"), nil
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}", nil
+		}
+		elem, err := g.goType(s.Items, false)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		if len(s.Properties) == 0 {
+			if s.AdditionalProperties != nil {
+				elem, err := g.goType(s.AdditionalProperties, false)
+				if err != nil {
+					return "", err
+				}
+				return "map[string]" + elem, nil
+			}
+			return "map[string]interface{}", nil
+		}
+		var buf bytes.Buffer
+		if err := g.object(&buf, s, ""); err != nil {
+			return "", err
+		}
+		return pointer(buf.String()), nil
+	}
+	return "interface{}", nil
+}
+
+// read returns a referenced schema, reading each file once
+func (g *generator) read(file string) (*schema, error) {
+	if s, ok := g.schemas[file]; ok {
+		return s, nil
+	}
+	s, err := readSchema(file)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := filepath.Rel(g.dir, file)
+	if err != nil {
+		return nil, err
+	}
+	g.override(filepath.ToSlash(rel), s)
+	g.schemas[file] = s
+	return s, nil
+}
+
+// goName converts a JSON name, such as html_url, to a Go name, such as HTMLURL
+func goName(name string) string {
+	switch name {
+	case "+1":
+		return "PlusOne"
+	case "-1":
+		return "MinusOne"
+	}
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' ' || r == '.'
+	})
+	var b strings.Builder
+	for _, word := range words {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
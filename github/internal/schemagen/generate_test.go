@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	assert := require.New(t)
+
+	files, err := generate("../../schemas", "../..")
+	assert.NoError(err)
+	assert.Len(files, 4)
+
+	for name, data := range files {
+		current, err := os.ReadFile(filepath.Join("../..", name))
+		assert.NoError(err)
+		assert.Equal(string(current), string(data), "%s is out of date, run go generate in the github directory", name)
+	}
+}
+
+// TestHandwrittenTypes fails when a shared type written by hand differs from its schema, ignoring the unexported
+// fields
+func TestHandwrittenTypes(t *testing.T) {
+	assert := require.New(t)
+
+	g, err := newGenerator("../../schemas")
+	assert.NoError(err)
+	declared := declaredTypes(t, "../..")
+
+	files := make(map[string]string)
+	for file, name := range g.manifest.Types {
+		files[name] = file
+	}
+	for _, name := range g.manifest.Handwritten {
+		s, err := g.read(filepath.Join(g.dir, files[name]))
+		assert.NoError(err, name)
+		typ, err := g.goType(s, false)
+		assert.NoError(err, name)
+		f, err := parser.ParseFile(token.NewFileSet(), "", "package github\ntype "+name+" "+typ, 0)
+		assert.NoError(err, name)
+		expected := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type
+
+		assert.Contains(declared, name)
+		assert.Equal(describe(expected), describe(declared[name]), "%s differs from %s", name, files[name])
+	}
+}
+
+// declaredTypes returns the types declared in the Go files of dir, by name
+func declaredTypes(t *testing.T, dir string) map[string]ast.Expr {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	require.NoError(t, err)
+
+	types := make(map[string]ast.Expr)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		require.NoError(t, err)
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok {
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						types[spec.Name.Name] = spec.Type
+					}
+				}
+			}
+		}
+	}
+	return types
+}
+
+// describe returns the exported fields of a struct with their type and tag, or the type itself
+func describe(typ ast.Expr) []string {
+	print := func(node ast.Node) string {
+		var buf bytes.Buffer
+		_ = printer.Fprint(&buf, token.NewFileSet(), node)
+		return buf.String()
+	}
+	st, ok := typ.(*ast.StructType)
+	if !ok {
+		return []string{print(typ)}
+	}
+	var fields []string
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				fields = append(fields, ident.Name+" "+print(field.Type)+" "+field.Tag.Value)
+			}
+		}
+	}
+	return fields
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"html_url":                 "HTMLURL",
+		"node_id":                  "NodeID",
+		"github_app_authorization": "GitHubAppAuthorization",
+		"projects_v2_item":         "ProjectsV2Item",
+		"ready_for_review":         "ReadyForReview",
+		"+1":                       "PlusOne",
+	}
+	for name, expected := range tests {
+		require.Equal(t, expected, goName(name), name)
+	}
+}
+
+func TestGoType(t *testing.T) {
+	assert := require.New(t)
+
+	g := generator{
+		dir:      "schemas",
+		manifest: manifest{Types: map[string]string{"common/user.schema.json": "User"}},
+		schemas:  make(map[string]*schema),
+		imports:  make(map[string]bool),
+	}
+	file := filepath.Join("schemas", "event", "created.schema.json")
+
+	tests := []struct {
+		schema   string
+		nullable bool
+		expected string
+	}{
+		{schema: `{"type": "string"}`, expected: "string"},
+		{schema: `{"type": ["string", "null"]}`, expected: "*string"},
+		{schema: `{"type": "string", "format": "date-time"}`, expected: "Timestamp"},
+		{schema: `{"type": "integer"}`, expected: "int64"},
+		{schema: `{"type": "array", "items": {"type": "number"}}`, expected: "[]float64"},
+		{schema: `{"type": "object", "properties": {"id": {"type": "integer"}}}`, expected: "struct {\nID int64 `json:\"id,omitempty\"`\n}"},
+		{schema: `{"$ref": "../common/user.schema.json"}`, expected: "User"},
+		{schema: `{"$ref": "../common/user.schema.json"}`, nullable: true, expected: "*User"},
+		{schema: `{"oneOf": [{"$ref": "../common/user.schema.json"}, {"type": "null"}]}`, expected: "*User"},
+		{schema: `{"oneOf": [{"type": "string"}, {"type": "integer"}]}`, expected: "interface{}"},
+		{schema: `{"type": "object", "additionalProperties": {"type": "string"}}`, expected: "map[string]string"},
+	}
+	for _, tc := range tests {
+		s := new(schema)
+		assert.NoError(s.UnmarshalJSON([]byte(tc.schema)), tc.schema)
+		s.setFile(file)
+		typ, err := g.goType(s, tc.nullable)
+		assert.NoError(err, tc.schema)
+		assert.Equal(tc.expected, typ, tc.schema)
+	}
+
+	// type overrides are used as is, nullable or not
+	typ, err := g.goType(&schema{GoType: "int", Type: []string{"integer", "null"}}, false)
+	assert.NoError(err)
+	assert.Equal("int", typ)
+	typ, err = g.goType(&schema{GoType: "json.RawMessage"}, false)
+	assert.NoError(err)
+	assert.Equal("json.RawMessage", typ)
+	assert.True(g.imports["encoding/json"])
+
+	_, err = g.goType(&schema{GoType: "yaml.Node"}, false)
+	assert.Error(err)
+}
+
+func TestOverride(t *testing.T) {
+	assert := require.New(t)
+
+	g := generator{
+		manifest: manifest{Overrides: map[string]map[string]override{
+			"push": {
+				"commits.id":     {Name: "Sha"},
+				"head_commit":    {Type: "*Commit"},
+				"pusher.unknown": {Name: "Unknown"},
+				"size":           {Type: "int"},
+				"commits.added":  {Type: "[]Path"},
+			},
+		}},
+		used: make(map[string]bool),
+	}
+	s := new(schema)
+	assert.NoError(s.UnmarshalJSON([]byte(`{"type": "object", "properties": {
+		"size": {"type": "integer"},
+		"head_commit": {"oneOf": [{"type": "object", "properties": {"id": {"type": "string"}}}, {"type": "null"}]},
+		"commits": {"type": "array", "items": {"type": "object", "properties": {
+			"id": {"type": "string"},
+			"added": {"type": "array", "items": {"type": "string"}}
+		}}}
+	}}`)))
+	g.override("push", s)
+
+	assert.Equal("int", s.property("size").GoType)
+	assert.Equal("*Commit", s.property("head_commit").GoType)
+	// the variants of a property keep their generated type
+	assert.Empty(s.property("head_commit").OneOf[0].GoType)
+	assert.Empty(s.property("head_commit").OneOf[0].property("id").GoName)
+	commit := s.property("commits").Items
+	assert.Equal("Sha", commit.property("id").GoName)
+	assert.Equal("[]Path", commit.property("added").GoType)
+	assert.True(g.used["push commits.id"])
+	assert.False(g.used["push pusher.unknown"])
+}
+
+func TestUnusedOverride(t *testing.T) {
+	assert := require.New(t)
+
+	dir := t.TempDir()
+	assert.NoError(os.MkdirAll(filepath.Join(dir, "ping"), 0o755))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "ping", "event.schema.json"), []byte(`{"type": "object", "properties": {"zen": {"type": "string"}}}`), 0o644))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "events.json"), []byte(`{"overrides": {"ping": {"hook_id": {"type": "int"}}}}`), 0o644))
+
+	_, err := generate(dir, "../..")
+	assert.EqualError(err, "events.json: override of ping hook_id matches no property")
+}
@@ -0,0 +1,48 @@
+// Command schemagen generates the GitHub event constants, the Parse switch, the payloads of the events, their
+// action types and the shared types from the webhook schemas in the github/schemas directory, one directory per event
+// and the shared schemas in common.
+//
+// schemas/events.json maps the common schemas to the shared types of the github package, such as User or Repository,
+// lists the shared types written by hand, lists the aliases of the events and overrides the Go name or type of
+// properties, keeping the schemas as published. The schemas of an event are either one file per action, as published
+// by GitHub, or a single file for the event.
+//
+// The payloads also get the accessors of the Payload interface, from their Installation, Repository, Sender,
+// Organization and Enterprise fields, unless written by hand.
+//
+// It is run by go generate in the github directory. With -vendor, it instead replaces the schemas with those
+// published in a checkout of github.com/octokit/webhooks, recording the -version of the checkout in
+// schemas/UPSTREAM; the generation then reports the overrides of events.json left without a property.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	schemas := flag.String("schemas", "schemas", "directory of the webhook schemas")
+	out := flag.String("out", ".", "directory of the generated files")
+	checkout := flag.String("vendor", "", "checkout of github.com/octokit/webhooks to vendor the schemas from")
+	version := flag.String("version", "", "tag or commit of the vendored checkout")
+	flag.Parse()
+
+	if *checkout != "" {
+		if err := vendor(*schemas, *checkout, *version); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	files, err := generate(*schemas, *out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), data, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
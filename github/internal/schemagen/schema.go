@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// manifest configures the generation, in schemas/events.json; the events are the directories of the schemas
+type manifest struct {
+	// Types maps the common schemas to the shared types of the github package
+	Types map[string]string `json:"types"`
+
+	// Handwritten lists the shared types written by hand in the github package, the others being generated
+	Handwritten []string `json:"handwritten"`
+
+	// Aliases lists the other names GitHub sends an event with, by event
+	Aliases map[string][]string `json:"aliases"`
+
+	// Overrides sets the Go name or type of properties, by event or common schema and by property path
+	Overrides map[string]map[string]override `json:"overrides"`
+}
+
+// override replaces the Go field name or type generated for a property, keeping the schemas as published
+type override struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// schema is the subset of JSON Schema used by the GitHub webhook schemas, keeping the order of the properties.
+// GoType and GoName are set from the overrides of the manifest.
+type schema struct {
+	Ref                  string
+	Title                string
+	Description          string
+	GoType               string
+	GoName               string
+	Type                 []string
+	Format               string
+	Enum                 []string
+	Properties           []property
+	Required             []string
+	Items                *schema
+	OneOf                []*schema
+	AnyOf                []*schema
+	AllOf                []*schema
+	AdditionalProperties *schema
+
+	// file is the path of the file the schema was read from, resolving its references
+	file string
+}
+
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// UnmarshalJSON decodes the schema, type being either a string or a list of strings and properties being
+// decoded in order
+func (s *schema) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Ref                  string          `json:"$ref"`
+		Title                string          `json:"title"`
+		Description          string          `json:"description"`
+		Type                 json.RawMessage `json:"type"`
+		Format               string          `json:"format"`
+		Enum                 []interface{}   `json:"enum"`
+		Properties           json.RawMessage `json:"properties"`
+		Required             []string        `json:"required"`
+		Items                *schema         `json:"items"`
+		OneOf                []*schema       `json:"oneOf"`
+		AnyOf                []*schema       `json:"anyOf"`
+		AllOf                []*schema       `json:"allOf"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*s = schema{
+		Ref:         raw.Ref,
+		Title:       raw.Title,
+		Description: raw.Description,
+		Format:      raw.Format,
+		Required:    raw.Required,
+		Items:       raw.Items,
+		OneOf:       raw.OneOf,
+		AnyOf:       raw.AnyOf,
+		AllOf:       raw.AllOf,
+	}
+	for _, value := range raw.Enum {
+		if value, ok := value.(string); ok {
+			s.Enum = append(s.Enum, value)
+		}
+	}
+
+	if len(raw.Type) > 0 {
+		if raw.Type[0] == '[' {
+			if err := json.Unmarshal(raw.Type, &s.Type); err != nil {
+				return err
+			}
+		} else {
+			var typ string
+			if err := json.Unmarshal(raw.Type, &typ); err != nil {
+				return err
+			}
+			s.Type = []string{typ}
+		}
+	}
+
+	// additionalProperties is either a boolean or the schema of the values
+	if len(raw.AdditionalProperties) > 0 && raw.AdditionalProperties[0] == '{' {
+		if err := json.Unmarshal(raw.AdditionalProperties, &s.AdditionalProperties); err != nil {
+			return err
+		}
+	}
+
+	if len(raw.Properties) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw.Properties))
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		p := property{Name: token.(string)}
+		if err := dec.Decode(&p.Schema); err != nil {
+			return fmt.Errorf("property %s: %w", p.Name, err)
+		}
+		s.Properties = append(s.Properties, p)
+	}
+	return nil
+}
+
+// nullable reports whether the schema accepts null
+func (s *schema) nullable() bool {
+	for _, typ := range s.Type {
+		if typ == "null" {
+			return true
+		}
+	}
+	for _, variants := range [][]*schema{s.OneOf, s.AnyOf} {
+		for _, variant := range variants {
+			if len(variant.Type) == 1 && variant.Type[0] == "null" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// kind returns the type of the schema other than null, empty when it has none or several
+func (s *schema) kind() string {
+	var kind string
+	for _, typ := range s.Type {
+		if typ == "null" {
+			continue
+		}
+		if kind != "" {
+			return ""
+		}
+		kind = typ
+	}
+	if kind == "" && len(s.Properties) > 0 {
+		return "object"
+	}
+	return kind
+}
+
+func (s *schema) required(name string) bool {
+	for _, r := range s.Required {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *schema) property(name string) *schema {
+	for _, p := range s.Properties {
+		if p.Name == name {
+			return p.Schema
+		}
+	}
+	return nil
+}
+
+// walk calls fn with every property schema and its path, the names of the properties leading to it joined by
+// dots; the items, variants and additional properties of a schema share its path
+func (s *schema) walk(path string, fn func(path string, s *schema)) {
+	if s == nil {
+		return
+	}
+	for _, p := range s.Properties {
+		child := p.Name
+		if path != "" {
+			child = path + "." + p.Name
+		}
+		fn(child, p.Schema)
+		p.Schema.walk(child, fn)
+	}
+	s.Items.walk(path, fn)
+	s.AdditionalProperties.walk(path, fn)
+	for _, variants := range [][]*schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, variant := range variants {
+			variant.walk(path, fn)
+		}
+	}
+}
+
+// setFile records the file the schema and its subschemas were read from
+func (s *schema) setFile(file string) {
+	if s == nil {
+		return
+	}
+	s.file = file
+	for _, p := range s.Properties {
+		p.Schema.setFile(file)
+	}
+	s.Items.setFile(file)
+	s.AdditionalProperties.setFile(file)
+	for _, variants := range [][]*schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, variant := range variants {
+			variant.setFile(file)
+		}
+	}
+}
+
+func readSchema(file string) (*schema, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var s *schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	s.setFile(file)
+	return s, nil
+}
+
+// readEvent reads the schemas of an event, one file per action as published by GitHub or a single file for the
+// event, and merges them
+func readEvent(dir, event string) (*schema, error) {
+	files, err := filepath.Glob(filepath.Join(dir, event, "*.schema.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var merged *schema
+	for _, file := range files {
+		s, err := readSchema(file)
+		if err != nil {
+			return nil, err
+		}
+		merged = merge(merged, s)
+	}
+	if merged == nil {
+		return nil, fmt.Errorf("%s: no schema", event)
+	}
+	return merged, nil
+}
+
+// merge combines the schemas of two actions of an event: properties are the union of both, in order of
+// appearance, required only when required by both, and the action enums are concatenated
+func merge(a, b *schema) *schema {
+	if a == nil {
+		return b
+	}
+	if a.kind() != "object" || b.kind() != "object" {
+		if len(a.Enum) > 0 && len(b.Enum) > 0 {
+			merged := *a
+			merged.Enum = append(append([]string{}, a.Enum...), b.Enum...)
+			return &merged
+		}
+		return a
+	}
+
+	merged := *a
+	merged.Properties = nil
+	merged.Required = nil
+	for _, p := range a.Properties {
+		if other := b.property(p.Name); other != nil {
+			merged.Properties = append(merged.Properties, property{Name: p.Name, Schema: merge(p.Schema, other)})
+			if a.required(p.Name) && b.required(p.Name) {
+				merged.Required = append(merged.Required, p.Name)
+			}
+			continue
+		}
+		merged.Properties = append(merged.Properties, p)
+	}
+	for _, p := range b.Properties {
+		if a.property(p.Name) == nil {
+			merged.Properties = append(merged.Properties, p)
+		}
+	}
+	if a.nullable() || b.nullable() {
+		merged.Type = append(append([]string{}, a.Type...), b.Type...)
+	}
+	return &merged
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// upstreamSchemas is the directory of the webhook schemas in a checkout of github.com/octokit/webhooks
+var upstreamSchemas = filepath.Join("payload-schemas", "api.github.com")
+
+// vendor replaces the schemas of the events of dir, and the common schemas, with the schemas published in a checkout
+// of github.com/octokit/webhooks, copied unmodified, and records the version of the checkout in dir/UPSTREAM
+func vendor(dir, checkout, version string) error {
+	if version == "" {
+		return fmt.Errorf("the version of %s is required", checkout)
+	}
+	src := filepath.Join(checkout, upstreamSchemas)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	// every event must be published before any schema is replaced
+	published := make(map[string][]string)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(src, entry.Name(), "*.schema.json"))
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("%s: no schema in %s", entry.Name(), src)
+		}
+		published[entry.Name()] = files
+	}
+
+	for name, files := range published {
+		current, err := filepath.Glob(filepath.Join(dir, name, "*.schema.json"))
+		if err != nil {
+			return err
+		}
+		for _, file := range current {
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(dir, name, filepath.Base(file)), data, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	return os.WriteFile(filepath.Join(dir, "UPSTREAM"), []byte("github.com/octokit/webhooks "+version+"\n"), 0o644)
+}
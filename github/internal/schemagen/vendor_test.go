@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVendor(t *testing.T) {
+	assert := require.New(t)
+
+	write := func(file, content string) {
+		assert.NoError(os.MkdirAll(filepath.Dir(file), 0o755))
+		assert.NoError(os.WriteFile(file, []byte(content), 0o644))
+	}
+	checkout := t.TempDir()
+	src := filepath.Join(checkout, upstreamSchemas)
+	write(filepath.Join(src, "ping", "event.schema.json"), `{"title": "ping event"}`)
+	write(filepath.Join(src, "star", "created.schema.json"), `{"title": "star created event"}`)
+	write(filepath.Join(src, "star", "deleted.schema.json"), `{"title": "star deleted event"}`)
+	write(filepath.Join(src, "watch", "started.schema.json"), `{"title": "watch started event"}`)
+	write(filepath.Join(src, "common", "user.schema.json"), `{"title": "User"}`)
+
+	dir := t.TempDir()
+	write(filepath.Join(dir, "events.json"), `{}`)
+	write(filepath.Join(dir, "ping", "event.schema.json"), `{"title": "derived"}`)
+	write(filepath.Join(dir, "star", "event.schema.json"), `{"title": "derived"}`)
+	write(filepath.Join(dir, "common", "user.schema.json"), `{"title": "derived"}`)
+
+	assert.Error(vendor(dir, checkout, ""))
+	assert.NoError(vendor(dir, checkout, "v7.6.1"))
+
+	read := func(file string) string {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		assert.NoError(err)
+		return string(data)
+	}
+	assert.Equal(`{"title": "ping event"}`, read("ping/event.schema.json"))
+	assert.Equal(`{"title": "star created event"}`, read("star/created.schema.json"))
+	assert.Equal(`{"title": "star deleted event"}`, read("star/deleted.schema.json"))
+	assert.NoFileExists(filepath.Join(dir, "star", "event.schema.json"))
+	assert.Equal(`{"title": "User"}`, read("common/user.schema.json"))
+	// only the events of dir are vendored
+	assert.NoDirExists(filepath.Join(dir, "watch"))
+	assert.Equal("github.com/octokit/webhooks v7.6.1\n", read("UPSTREAM"))
+
+	write(filepath.Join(dir, "meta", "event.schema.json"), `{"title": "derived"}`)
+	write(filepath.Join(dir, "ping", "event.schema.json"), `{"title": "derived"}`)
+	assert.EqualError(vendor(dir, checkout, "v7.6.2"), "meta: no schema in "+src)
+	// nothing is replaced when an event is missing
+	assert.Equal(`{"title": "derived"}`, read("ping/event.schema.json"))
+	assert.Equal("github.com/octokit/webhooks v7.6.1\n", read("UPSTREAM"))
+}
@@ -0,0 +1,307 @@
+package github
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultJobRetention is the time a JobTracker remembers completed jobs, ignoring their late or redelivered
+// events, when created with a zero retention
+const DefaultJobRetention = 24 * time.Hour
+
+// maxJobTombstones bounds the IDs of the completed jobs remembered after the retention
+const maxJobTombstones = 100000
+
+// JobCounts contains the number of workflow jobs by status; Completed counts the jobs completed within the
+// retention by label set and repository, and every job completed since the tracker was created in the total
+type JobCounts struct {
+	Waiting    int
+	Queued     int
+	InProgress int
+	Completed  int
+}
+
+// JobSnapshot contains the workflow job counts of a JobTracker at a point in time
+type JobSnapshot struct {
+	// Labels contains the counts by runner label set, keyed by LabelSetKey, omitting the label sets without jobs
+	Labels map[string]JobCounts
+
+	// Repositories contains the counts by repository full name, omitting the repositories without jobs
+	Repositories map[string]JobCounts
+
+	Total JobCounts
+}
+
+// JobChange describes the status change of a workflow job, sent to the subscribers of a JobTracker
+type JobChange struct {
+	JobID      int64
+	Repository string
+	Labels     string
+	// From is empty for a job seen for the first time
+	From WorkflowJobAction
+	// To is empty for a job dropped by Forget
+	To WorkflowJobAction
+}
+
+// JobTracker counts the waiting, queued, in progress and completed workflow jobs, by runner label set and by
+// repository, from the workflow_job events, for autoscaling self-hosted runners. Events are ignored when older
+// than the last one seen for the job, making the counts correct under duplicated and out of order deliveries.
+// Completed jobs are forgotten after the retention, only their IDs being kept, up to a bound, so their events
+// delivered later are still ignored. Jobs whose completion is never delivered are kept until dropped with
+// Forget, typically after reconciling the tracker against the jobs listed by the API.
+//
+// It is safe for concurrent use.
+type JobTracker struct {
+	mu          sync.Mutex
+	retention   time.Duration
+	now         func() time.Time
+	jobs        map[int64]*trackedJob
+	completed   []completedJob
+	tombstones  map[int64]struct{}
+	forgotten   []int64
+	labels      map[string]*JobCounts
+	repos       map[string]*JobCounts
+	total       JobCounts
+	subscribers map[chan JobChange]struct{}
+}
+
+type trackedJob struct {
+	status WorkflowJobAction
+	repo   string
+	labels string
+}
+
+type completedJob struct {
+	id int64
+	at time.Time
+}
+
+// NewJobTracker returns a JobTracker remembering completed jobs for the given retention, DefaultJobRetention when
+// zero
+func NewJobTracker(retention time.Duration) *JobTracker {
+	if retention <= 0 {
+		retention = DefaultJobRetention
+	}
+	return &JobTracker{
+		retention:   retention,
+		now:         time.Now,
+		jobs:        make(map[int64]*trackedJob),
+		tombstones:  make(map[int64]struct{}),
+		labels:      make(map[string]*JobCounts),
+		repos:       make(map[string]*JobCounts),
+		subscribers: make(map[chan JobChange]struct{}),
+	}
+}
+
+// LabelSetKey returns the key of a runner label set in JobSnapshot.Labels, the sorted lower case labels joined
+// by commas, labels being case insensitive
+func LabelSetKey(labels []string) string {
+	set := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = strings.ToLower(label)
+		if !seen[label] {
+			seen[label] = true
+			set = append(set, label)
+		}
+	}
+	sort.Strings(set)
+	return strings.Join(set, ",")
+}
+
+// Track updates the counts with a workflow_job event, returning false when the event was ignored, being a
+// duplicate, older than the last event of the job or of an unknown action
+func (t *JobTracker) Track(pl WorkflowJobPayload) bool {
+	rank := jobRank(pl.Action)
+	if rank == 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.prune(now)
+
+	id := pl.WorkflowJob.ID
+	if _, ok := t.tombstones[id]; ok {
+		return false
+	}
+	job, ok := t.jobs[id]
+	if ok && jobRank(job.status) >= rank {
+		return false
+	}
+
+	change := JobChange{JobID: id, To: pl.Action}
+	if ok {
+		change.From = job.status
+		t.count(job, -1, true)
+		job.status = pl.Action
+	} else {
+		job = &trackedJob{
+			status: pl.Action,
+			repo:   pl.Repository.FullName,
+			labels: LabelSetKey(pl.WorkflowJob.Labels),
+		}
+		t.jobs[id] = job
+	}
+	t.count(job, 1, true)
+	if pl.Action == WorkflowJobActionCompleted {
+		t.completed = append(t.completed, completedJob{id: id, at: now})
+	}
+
+	change.Repository = job.repo
+	change.Labels = job.labels
+	t.notify(change)
+	return true
+}
+
+// Forget drops a job not completed yet from the counts, ignoring its later events, returning false when the job
+// is unknown or completed; it lets callers remove the jobs whose completion was never delivered
+func (t *JobTracker) Forget(id int64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	job, ok := t.jobs[id]
+	if !ok || job.status == WorkflowJobActionCompleted {
+		return false
+	}
+	t.count(job, -1, true)
+	delete(t.jobs, id)
+	t.bury(id)
+	t.notify(JobChange{JobID: id, Repository: job.repo, Labels: job.labels, From: job.status})
+	return true
+}
+
+// Snapshot returns the current counts
+func (t *JobTracker) Snapshot() JobSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	snapshot := JobSnapshot{
+		Labels:       make(map[string]JobCounts, len(t.labels)),
+		Repositories: make(map[string]JobCounts, len(t.repos)),
+		Total:        t.total,
+	}
+	for key, counts := range t.labels {
+		snapshot.Labels[key] = *counts
+	}
+	for key, counts := range t.repos {
+		snapshot.Repositories[key] = *counts
+	}
+	return snapshot
+}
+
+// Subscribe returns a channel receiving the changes tracked from now on, buffering up to size changes; changes
+// are dropped while the buffer is full. The returned function unsubscribes and closes the channel.
+func (t *JobTracker) Subscribe(size int) (<-chan JobChange, func()) {
+	ch := make(chan JobChange, size)
+
+	t.mu.Lock()
+	t.subscribers[ch] = struct{}{}
+	t.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			t.mu.Lock()
+			delete(t.subscribers, ch)
+			t.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// notify sends a change to the subscribers
+func (t *JobTracker) notify(change JobChange) {
+	for ch := range t.subscribers {
+		// a subscriber not keeping up misses changes, the snapshot staying accurate
+		select {
+		case ch <- change:
+		default:
+		}
+	}
+}
+
+// count adds delta to the counts of the job status, in the total too unless total is false, dropping the label
+// set and repository counts left empty
+func (t *JobTracker) count(job *trackedJob, delta int, total bool) {
+	labels, ok := t.labels[job.labels]
+	if !ok {
+		labels = &JobCounts{}
+		t.labels[job.labels] = labels
+	}
+	repo, ok := t.repos[job.repo]
+	if !ok {
+		repo = &JobCounts{}
+		t.repos[job.repo] = repo
+	}
+	all := []*JobCounts{labels, repo}
+	if total {
+		all = append(all, &t.total)
+	}
+	for _, counts := range all {
+		switch job.status {
+		case WorkflowJobActionWaiting:
+			counts.Waiting += delta
+		case WorkflowJobActionQueued:
+			counts.Queued += delta
+		case WorkflowJobActionInProgress:
+			counts.InProgress += delta
+		case WorkflowJobActionCompleted:
+			counts.Completed += delta
+		}
+	}
+	if *labels == (JobCounts{}) {
+		delete(t.labels, job.labels)
+	}
+	if *repo == (JobCounts{}) {
+		delete(t.repos, job.repo)
+	}
+}
+
+// prune forgets the jobs completed before the retention, keeping their IDs as tombstones; they leave the label
+// set and repository counts, the total keeping every completed job
+func (t *JobTracker) prune(now time.Time) {
+	var i int
+	for i < len(t.completed) && now.Sub(t.completed[i].at) > t.retention {
+		id := t.completed[i].id
+		if job, ok := t.jobs[id]; ok {
+			t.count(job, -1, false)
+			delete(t.jobs, id)
+		}
+		t.bury(id)
+		i++
+	}
+	t.completed = t.completed[i:]
+}
+
+// bury keeps the ID of a forgotten job, so its later events are ignored, dropping the oldest past the bound
+func (t *JobTracker) bury(id int64) {
+	t.tombstones[id] = struct{}{}
+	t.forgotten = append(t.forgotten, id)
+
+	if extra := len(t.forgotten) - maxJobTombstones; extra > 0 {
+		for _, id := range t.forgotten[:extra] {
+			delete(t.tombstones, id)
+		}
+		t.forgotten = t.forgotten[extra:]
+	}
+}
+
+// jobRank orders the workflow job actions in the order GitHub sends them, 0 for unknown actions
+func jobRank(action WorkflowJobAction) int {
+	switch action {
+	case WorkflowJobActionWaiting:
+		return 1
+	case WorkflowJobActionQueued:
+		return 2
+	case WorkflowJobActionInProgress:
+		return 3
+	case WorkflowJobActionCompleted:
+		return 4
+	}
+	return 0
+}
@@ -0,0 +1,195 @@
+package github
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func workflowJob(id int64, action WorkflowJobAction, repo string, labels ...string) WorkflowJobPayload {
+	var pl WorkflowJobPayload
+	pl.Action = action
+	pl.WorkflowJob.ID = id
+	pl.WorkflowJob.Labels = labels
+	pl.Repository.FullName = repo
+	return pl
+}
+
+func TestJobTracker(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewJobTracker(0)
+	changes, unsubscribe := tracker.Subscribe(10)
+
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionQueued, "octo/api", "self-hosted", "linux")))
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionQueued, "octo/api", "Linux", "self-hosted")))
+	assert.True(tracker.Track(workflowJob(3, WorkflowJobActionWaiting, "octo/web", "self-hosted", "gpu")))
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionInProgress, "octo/api", "self-hosted", "linux")))
+
+	// duplicated delivery
+	assert.False(tracker.Track(workflowJob(1, WorkflowJobActionInProgress, "octo/api", "self-hosted", "linux")))
+	// completed delivered before in_progress
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionCompleted, "octo/api", "self-hosted", "linux")))
+	assert.False(tracker.Track(workflowJob(2, WorkflowJobActionInProgress, "octo/api", "self-hosted", "linux")))
+	// unknown action
+	assert.False(tracker.Track(workflowJob(4, "My workflow_job", "octo/api", "self-hosted", "linux")))
+
+	snapshot := tracker.Snapshot()
+	assert.Equal(JobCounts{InProgress: 1, Completed: 1}, snapshot.Labels[LabelSetKey([]string{"linux", "self-hosted"})])
+	assert.Equal(JobCounts{Waiting: 1}, snapshot.Labels["gpu,self-hosted"])
+	assert.Equal(JobCounts{InProgress: 1, Completed: 1}, snapshot.Repositories["octo/api"])
+	assert.Equal(JobCounts{Waiting: 1}, snapshot.Repositories["octo/web"])
+	assert.Equal(JobCounts{Waiting: 1, InProgress: 1, Completed: 1}, snapshot.Total)
+
+	unsubscribe()
+	unsubscribe()
+	var received []JobChange
+	for change := range changes {
+		received = append(received, change)
+	}
+	assert.Len(received, 5)
+	assert.Equal(JobChange{JobID: 1, Repository: "octo/api", Labels: "linux,self-hosted", To: WorkflowJobActionQueued}, received[0])
+	assert.Equal(JobChange{
+		JobID:      2,
+		Repository: "octo/api",
+		Labels:     "linux,self-hosted",
+		From:       WorkflowJobActionQueued,
+		To:         WorkflowJobActionCompleted,
+	}, received[4])
+
+	// the snapshot is a copy
+	snapshot.Total.Queued = 10
+	assert.Equal(0, tracker.Snapshot().Total.Queued)
+}
+
+func TestJobTrackerRetention(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+	tracker := NewJobTracker(time.Hour)
+	tracker.now = func() time.Time { return now }
+
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionCompleted, "octo/api", "linux")))
+	now = now.Add(30 * time.Minute)
+	assert.False(tracker.Track(workflowJob(1, WorkflowJobActionQueued, "octo/api", "linux")))
+
+	// forgotten after the retention
+	now = now.Add(time.Hour)
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionQueued, "octo/api", "linux")))
+	assert.Empty(tracker.completed)
+	assert.NotContains(tracker.jobs, int64(1))
+	assert.Equal(JobCounts{Queued: 1, Completed: 1}, tracker.Snapshot().Total)
+
+	// a forgotten job redelivered late is not counted again
+	assert.False(tracker.Track(workflowJob(1, WorkflowJobActionQueued, "octo/api", "linux")))
+	assert.False(tracker.Track(workflowJob(1, WorkflowJobActionInProgress, "octo/api", "linux")))
+	assert.Equal(JobCounts{Queued: 1, Completed: 1}, tracker.Snapshot().Total)
+}
+
+func TestJobTrackerEmptyCounts(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+	tracker := NewJobTracker(time.Hour)
+	tracker.now = func() time.Time { return now }
+
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionQueued, "octo/api", "linux")))
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionQueued, "octo/web", "gpu")))
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionCompleted, "octo/api", "linux")))
+	assert.Equal(JobCounts{Completed: 1}, tracker.Snapshot().Repositories["octo/api"])
+
+	// the label sets and repositories are dropped once their completed jobs are forgotten
+	now = now.Add(2 * time.Hour)
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionInProgress, "octo/web", "gpu")))
+	snapshot := tracker.Snapshot()
+	assert.Equal(map[string]JobCounts{"gpu": {InProgress: 1}}, snapshot.Labels)
+	assert.Equal(map[string]JobCounts{"octo/web": {InProgress: 1}}, snapshot.Repositories)
+	assert.Equal(JobCounts{InProgress: 1, Completed: 1}, snapshot.Total)
+	assert.Len(tracker.labels, 1)
+	assert.Len(tracker.repos, 1)
+}
+
+func TestJobTrackerForget(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewJobTracker(0)
+	changes, unsubscribe := tracker.Subscribe(10)
+	defer unsubscribe()
+
+	assert.True(tracker.Track(workflowJob(1, WorkflowJobActionInProgress, "octo/api", "linux")))
+	assert.True(tracker.Track(workflowJob(2, WorkflowJobActionCompleted, "octo/api", "linux")))
+
+	assert.False(tracker.Forget(2))
+	assert.False(tracker.Forget(3))
+	assert.True(tracker.Forget(1))
+	assert.False(tracker.Forget(1))
+
+	snapshot := tracker.Snapshot()
+	assert.Equal(JobCounts{Completed: 1}, snapshot.Total)
+	assert.Equal(map[string]JobCounts{"octo/api": {Completed: 1}}, snapshot.Repositories)
+	assert.NotContains(tracker.jobs, int64(1))
+
+	// the completion of a forgotten job delivered later is ignored
+	assert.False(tracker.Track(workflowJob(1, WorkflowJobActionCompleted, "octo/api", "linux")))
+	assert.Equal(JobCounts{Completed: 1}, tracker.Snapshot().Total)
+
+	<-changes
+	<-changes
+	assert.Equal(JobChange{JobID: 1, Repository: "octo/api", Labels: "linux", From: WorkflowJobActionInProgress}, <-changes)
+	assert.Empty(changes)
+}
+
+func TestJobTrackerTombstones(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+	tracker := NewJobTracker(time.Minute)
+	tracker.now = func() time.Time { return now }
+
+	for id := int64(1); id <= maxJobTombstones+10; id++ {
+		tracker.Track(workflowJob(id, WorkflowJobActionCompleted, "octo/api", "linux"))
+	}
+	now = now.Add(time.Hour)
+	assert.True(tracker.Track(workflowJob(maxJobTombstones+11, WorkflowJobActionQueued, "octo/api", "linux")))
+
+	// the oldest tombstones are dropped past the bound
+	assert.Len(tracker.tombstones, maxJobTombstones)
+	assert.Len(tracker.forgotten, maxJobTombstones)
+	assert.NotContains(tracker.tombstones, int64(10))
+	assert.Contains(tracker.tombstones, int64(11))
+	assert.False(tracker.Track(workflowJob(11, WorkflowJobActionQueued, "octo/api", "linux")))
+}
+
+func TestJobTrackerConcurrency(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewJobTracker(0)
+	_, unsubscribe := tracker.Subscribe(0)
+	defer unsubscribe()
+
+	actions := []WorkflowJobAction{
+		WorkflowJobActionQueued,
+		WorkflowJobActionInProgress,
+		WorkflowJobActionCompleted,
+	}
+	var wg sync.WaitGroup
+	for id := int64(1); id <= 50; id++ {
+		for _, action := range actions {
+			// every event is delivered twice, in any order
+			for i := 0; i < 2; i++ {
+				wg.Add(1)
+				go func(id int64, action WorkflowJobAction) {
+					defer wg.Done()
+					tracker.Track(workflowJob(id, action, "octo/api", "self-hosted"))
+					_ = tracker.Snapshot()
+				}(id, action)
+			}
+		}
+	}
+	wg.Wait()
+
+	assert.Equal(JobCounts{Completed: 50}, tracker.Snapshot().Total)
+	assert.Equal(JobCounts{Completed: 50}, tracker.Snapshot().Labels["self-hosted"])
+}
@@ -0,0 +1,3258 @@
+// Code generated by schemagen from the webhook schemas. DO NOT EDIT.
+
+package github
+
+import "encoding/json"
+
+// BranchProtectionConfigurationPayload contains the information for GitHub's branch_protection_configuration hook event
+type BranchProtectionConfigurationPayload struct {
+	Action       BranchProtectionConfigurationAction `json:"action"`
+	Repository   Repository                          `json:"repository"`
+	Organization Organization                        `json:"organization,omitempty"`
+	Enterprise   *Enterprise                         `json:"enterprise,omitempty"`
+	Sender       User                                `json:"sender"`
+	Installation Installation                        `json:"installation,omitempty"`
+}
+
+// BranchProtectionConfigurationAction is the action of the branch_protection_configuration event
+type BranchProtectionConfigurationAction string
+
+// branch_protection_configuration actions
+const (
+	BranchProtectionConfigurationActionDisabled BranchProtectionConfigurationAction = "disabled"
+	BranchProtectionConfigurationActionEnabled  BranchProtectionConfigurationAction = "enabled"
+)
+
+// Event returns the branch_protection_configuration event restricted to the action, for filtering it in Parse
+func (a BranchProtectionConfigurationAction) Event() Event {
+	return BranchProtectionConfigurationEvent.WithAction(string(a))
+}
+
+// BranchProtectionRulePayload contains the information for GitHub's branch_protection_rule hook event
+type BranchProtectionRulePayload struct {
+	Action  BranchProtectionRuleAction `json:"action"`
+	Rule    BranchProtectionRule       `json:"rule"`
+	Changes *struct {
+		AdminEnforced *struct {
+			From bool `json:"from"`
+		} `json:"admin_enforced,omitempty"`
+		AllowDeletionsEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"allow_deletions_enforcement_level,omitempty"`
+		AllowForcePushesEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"allow_force_pushes_enforcement_level,omitempty"`
+		AuthorizedActorNames *struct {
+			From []string `json:"from"`
+		} `json:"authorized_actor_names,omitempty"`
+		AuthorizedActorsOnly *struct {
+			From bool `json:"from"`
+		} `json:"authorized_actors_only,omitempty"`
+		AuthorizedDismissalActorsOnly *struct {
+			From bool `json:"from"`
+		} `json:"authorized_dismissal_actors_only,omitempty"`
+		DismissStaleReviewsOnPush *struct {
+			From bool `json:"from"`
+		} `json:"dismiss_stale_reviews_on_push,omitempty"`
+		IgnoreApprovalsFromContributors *struct {
+			From bool `json:"from"`
+		} `json:"ignore_approvals_from_contributors,omitempty"`
+		LinearHistoryRequirementEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"linear_history_requirement_enforcement_level,omitempty"`
+		LockAllowsForkSync *struct {
+			From bool `json:"from"`
+		} `json:"lock_allows_fork_sync,omitempty"`
+		LockBranchEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"lock_branch_enforcement_level,omitempty"`
+		MergeQueueEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"merge_queue_enforcement_level,omitempty"`
+		PullRequestReviewsEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"pull_request_reviews_enforcement_level,omitempty"`
+		RequireCodeOwnerReview *struct {
+			From bool `json:"from"`
+		} `json:"require_code_owner_review,omitempty"`
+		RequireLastPushApproval *struct {
+			From bool `json:"from"`
+		} `json:"require_last_push_approval,omitempty"`
+		RequiredApprovingReviewCount *struct {
+			From int64 `json:"from"`
+		} `json:"required_approving_review_count,omitempty"`
+		RequiredConversationResolutionLevel *struct {
+			From string `json:"from"`
+		} `json:"required_conversation_resolution_level,omitempty"`
+		RequiredDeploymentsEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"required_deployments_enforcement_level,omitempty"`
+		RequiredStatusChecks *struct {
+			From []string `json:"from"`
+		} `json:"required_status_checks,omitempty"`
+		RequiredStatusChecksEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"required_status_checks_enforcement_level,omitempty"`
+		SignatureRequirementEnforcementLevel *struct {
+			From string `json:"from"`
+		} `json:"signature_requirement_enforcement_level,omitempty"`
+		StrictRequiredStatusChecksPolicy *struct {
+			From bool `json:"from"`
+		} `json:"strict_required_status_checks_policy,omitempty"`
+	} `json:"changes,omitempty"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// BranchProtectionRuleAction is the action of the branch_protection_rule event
+type BranchProtectionRuleAction string
+
+// branch_protection_rule actions
+const (
+	BranchProtectionRuleActionCreated BranchProtectionRuleAction = "created"
+	BranchProtectionRuleActionDeleted BranchProtectionRuleAction = "deleted"
+	BranchProtectionRuleActionEdited  BranchProtectionRuleAction = "edited"
+)
+
+// Event returns the branch_protection_rule event restricted to the action, for filtering it in Parse
+func (a BranchProtectionRuleAction) Event() Event {
+	return BranchProtectionRuleEvent.WithAction(string(a))
+}
+
+// CheckRunPayload contains the information for GitHub's check_run hook event
+type CheckRunPayload struct {
+	Action   CheckRunAction `json:"action"`
+	CheckRun struct {
+		ID          int64     `json:"id"`
+		NodeID      string    `json:"node_id"`
+		Name        string    `json:"name"`
+		HeadSHA     string    `json:"head_sha"`
+		ExternalID  string    `json:"external_id"`
+		Status      string    `json:"status"`
+		Conclusion  string    `json:"conclusion"`
+		URL         string    `json:"url"`
+		HTMLURL     string    `json:"html_url"`
+		DetailsURL  string    `json:"details_url"`
+		StartedAt   Timestamp `json:"started_at"`
+		CompletedAt Timestamp `json:"completed_at"`
+		Output      struct {
+			Title            string `json:"title"`
+			Summary          string `json:"summary"`
+			Text             string `json:"text"`
+			AnnotationsCount int64  `json:"annotations_count"`
+			AnnotationsURL   string `json:"annotations_url"`
+		} `json:"output"`
+		CheckSuite struct {
+			ID           int64              `json:"id"`
+			NodeID       string             `json:"node_id"`
+			HeadBranch   string             `json:"head_branch"`
+			HeadSHA      string             `json:"head_sha"`
+			Status       string             `json:"status"`
+			Conclusion   string             `json:"conclusion"`
+			URL          string             `json:"url"`
+			Before       string             `json:"before"`
+			After        string             `json:"after"`
+			PullRequests []CheckPullRequest `json:"pull_requests"`
+			App          App                `json:"app"`
+			CreatedAt    Timestamp          `json:"created_at"`
+			UpdatedAt    Timestamp          `json:"updated_at"`
+		} `json:"check_suite"`
+		App          App                `json:"app"`
+		PullRequests []CheckPullRequest `json:"pull_requests"`
+	} `json:"check_run"`
+	RequestedAction *CheckRunRequestedAction `json:"requested_action,omitempty"`
+	Repository      Repository               `json:"repository"`
+	Installation    Installation             `json:"installation,omitempty"`
+	Sender          User                     `json:"sender"`
+	Organization    *Organization            `json:"organization,omitempty"`
+	Enterprise      *Enterprise              `json:"enterprise,omitempty"`
+}
+
+// CheckRunAction is the action of the check_run event
+type CheckRunAction string
+
+// check_run actions
+const (
+	CheckRunActionCompleted       CheckRunAction = "completed"
+	CheckRunActionCreated         CheckRunAction = "created"
+	CheckRunActionRequestedAction CheckRunAction = "requested_action"
+	CheckRunActionRerequested     CheckRunAction = "rerequested"
+)
+
+// Event returns the check_run event restricted to the action, for filtering it in Parse
+func (a CheckRunAction) Event() Event {
+	return CheckRunEvent.WithAction(string(a))
+}
+
+// CheckSuitePayload contains the information for GitHub's check_suite hook event
+type CheckSuitePayload struct {
+	Action     CheckSuiteAction `json:"action"`
+	CheckSuite struct {
+		ID                   int64              `json:"id"`
+		NodeID               string             `json:"node_id"`
+		HeadBranch           string             `json:"head_branch"`
+		HeadSHA              string             `json:"head_sha"`
+		Status               string             `json:"status"`
+		Conclusion           string             `json:"conclusion"`
+		URL                  string             `json:"url"`
+		Before               string             `json:"before"`
+		After                string             `json:"after"`
+		PullRequests         []CheckPullRequest `json:"pull_requests"`
+		App                  App                `json:"app"`
+		CreatedAt            Timestamp          `json:"created_at"`
+		UpdatedAt            Timestamp          `json:"updated_at"`
+		Rerequestable        bool               `json:"rerequestable"`
+		RunsRerequestable    bool               `json:"runs_rerequestable"`
+		LatestCheckRunsCount int64              `json:"latest_check_runs_count"`
+		CheckRunsURL         string             `json:"check_runs_url"`
+		HeadCommit           Commit             `json:"head_commit"`
+	} `json:"check_suite"`
+	Repository   Repository    `json:"repository"`
+	Installation Installation  `json:"installation,omitempty"`
+	Sender       User          `json:"sender"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// CheckSuiteAction is the action of the check_suite event
+type CheckSuiteAction string
+
+// check_suite actions
+const (
+	CheckSuiteActionCompleted   CheckSuiteAction = "completed"
+	CheckSuiteActionRequested   CheckSuiteAction = "requested"
+	CheckSuiteActionRerequested CheckSuiteAction = "rerequested"
+)
+
+// Event returns the check_suite event restricted to the action, for filtering it in Parse
+func (a CheckSuiteAction) Event() Event {
+	return CheckSuiteEvent.WithAction(string(a))
+}
+
+// CodeScanningAlertPayload contains the information for GitHub's code_scanning_alert hook event
+type CodeScanningAlertPayload struct {
+	Action CodeScanningAlertAction `json:"action"`
+	Alert  struct {
+		Number           int         `json:"number"`
+		CreatedAt        Timestamp   `json:"created_at"`
+		UpdatedAt        Timestamp   `json:"updated_at"`
+		Url              string      `json:"url"`
+		HtmlUrl          string      `json:"html_url"`
+		State            string      `json:"state"`
+		FixedAt          *Timestamp  `json:"fixed_at"`
+		DismissedBy      interface{} `json:"dismissed_by"`
+		DismissedAt      *Timestamp  `json:"dismissed_at"`
+		DismissedReason  interface{} `json:"dismissed_reason"`
+		DismissedComment interface{} `json:"dismissed_comment"`
+		Rule             struct {
+			Id                    string   `json:"id"`
+			Severity              string   `json:"severity"`
+			Description           string   `json:"description"`
+			Name                  string   `json:"name"`
+			Tags                  []string `json:"tags"`
+			FullDescription       string   `json:"full_description"`
+			Help                  string   `json:"help"`
+			HelpUri               string   `json:"help_uri"`
+			SecuritySeverityLevel string   `json:"security_severity_level"`
+		} `json:"rule"`
+		Tool struct {
+			Name    string      `json:"name"`
+			Guid    interface{} `json:"guid"`
+			Version string      `json:"version"`
+		} `json:"tool"`
+		MostRecentInstance struct {
+			Ref         string `json:"ref"`
+			AnalysisKey string `json:"analysis_key"`
+			Environment string `json:"environment"`
+			Category    string `json:"category"`
+			State       string `json:"state"`
+			CommitSha   string `json:"commit_sha"`
+			Message     struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Location struct {
+				Path        string `json:"path"`
+				StartLine   int    `json:"start_line"`
+				EndLine     int    `json:"end_line"`
+				StartColumn int    `json:"start_column"`
+				EndColumn   int    `json:"end_column"`
+			} `json:"location"`
+			Classifications []interface{} `json:"classifications"`
+		} `json:"most_recent_instance"`
+		InstancesUrl string `json:"instances_url"`
+	} `json:"alert"`
+	Ref          string        `json:"ref"`
+	CommitOid    string        `json:"commit_oid"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Enterprise   Enterprise    `json:"enterprise,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// CodeScanningAlertAction is the action of the code_scanning_alert event
+type CodeScanningAlertAction string
+
+// code_scanning_alert actions
+const (
+	CodeScanningAlertActionAppearedInBranch CodeScanningAlertAction = "appeared_in_branch"
+	CodeScanningAlertActionClosedByUser     CodeScanningAlertAction = "closed_by_user"
+	CodeScanningAlertActionCreated          CodeScanningAlertAction = "created"
+	CodeScanningAlertActionFixed            CodeScanningAlertAction = "fixed"
+	CodeScanningAlertActionReopened         CodeScanningAlertAction = "reopened"
+	CodeScanningAlertActionReopenedByUser   CodeScanningAlertAction = "reopened_by_user"
+)
+
+// Event returns the code_scanning_alert event restricted to the action, for filtering it in Parse
+func (a CodeScanningAlertAction) Event() Event {
+	return CodeScanningAlertEvent.WithAction(string(a))
+}
+
+// CommitCommentPayload contains the information for GitHub's commit_comment hook event
+type CommitCommentPayload struct {
+	Action  CommitCommentAction `json:"action"`
+	Comment struct {
+		URL               string    `json:"url"`
+		HTMLURL           string    `json:"html_url"`
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		User              User      `json:"user"`
+		Position          *int64    `json:"position"`
+		Line              *int64    `json:"line"`
+		Path              *string   `json:"path"`
+		CommitID          string    `json:"commit_id"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		Body              string    `json:"body"`
+		Reactions         Reactions `json:"reactions,omitempty"`
+		AuthorAssociation string    `json:"author_association"`
+	} `json:"comment"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// CommitCommentAction is the action of the commit_comment event
+type CommitCommentAction string
+
+// commit_comment actions
+const (
+	CommitCommentActionCreated CommitCommentAction = "created"
+)
+
+// Event returns the commit_comment event restricted to the action, for filtering it in Parse
+func (a CommitCommentAction) Event() Event {
+	return CommitCommentEvent.WithAction(string(a))
+}
+
+// CreatePayload contains the information for GitHub's create hook event
+type CreatePayload struct {
+	Ref          string        `json:"ref"`
+	RefType      string        `json:"ref_type"`
+	MasterBranch string        `json:"master_branch"`
+	Description  string        `json:"description"`
+	PusherType   string        `json:"pusher_type"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// CustomPropertyPayload contains the information for GitHub's custom_property hook event
+type CustomPropertyPayload struct {
+	Action       CustomPropertyAction `json:"action"`
+	Definition   CustomProperty       `json:"definition"`
+	Organization Organization         `json:"organization"`
+	Enterprise   *Enterprise          `json:"enterprise,omitempty"`
+	Sender       User                 `json:"sender"`
+	Installation Installation         `json:"installation,omitempty"`
+}
+
+// CustomPropertyAction is the action of the custom_property event
+type CustomPropertyAction string
+
+// custom_property actions
+const (
+	CustomPropertyActionCreated              CustomPropertyAction = "created"
+	CustomPropertyActionDeleted              CustomPropertyAction = "deleted"
+	CustomPropertyActionPromotedToEnterprise CustomPropertyAction = "promoted_to_enterprise"
+	CustomPropertyActionUpdated              CustomPropertyAction = "updated"
+)
+
+// Event returns the custom_property event restricted to the action, for filtering it in Parse
+func (a CustomPropertyAction) Event() Event {
+	return CustomPropertyEvent.WithAction(string(a))
+}
+
+// CustomPropertyValuesPayload contains the information for GitHub's custom_property_values hook event
+type CustomPropertyValuesPayload struct {
+	Action            CustomPropertyValuesAction `json:"action"`
+	NewPropertyValues []CustomPropertyValue      `json:"new_property_values"`
+	OldPropertyValues []CustomPropertyValue      `json:"old_property_values"`
+	Repository        Repository                 `json:"repository"`
+	Organization      Organization               `json:"organization"`
+	Enterprise        *Enterprise                `json:"enterprise,omitempty"`
+	Sender            User                       `json:"sender"`
+	Installation      Installation               `json:"installation,omitempty"`
+}
+
+// CustomPropertyValuesAction is the action of the custom_property_values event
+type CustomPropertyValuesAction string
+
+// custom_property_values actions
+const (
+	CustomPropertyValuesActionUpdated CustomPropertyValuesAction = "updated"
+)
+
+// Event returns the custom_property_values event restricted to the action, for filtering it in Parse
+func (a CustomPropertyValuesAction) Event() Event {
+	return CustomPropertyValuesEvent.WithAction(string(a))
+}
+
+// DeletePayload contains the information for GitHub's delete hook event
+type DeletePayload struct {
+	Ref          string        `json:"ref"`
+	RefType      string        `json:"ref_type"`
+	PusherType   string        `json:"pusher_type"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// DependabotAlertPayload contains the information for GitHub's dependabot_alert hook event
+type DependabotAlertPayload struct {
+	// "created", "dissmissed", "fixed", "reintroduced", "reopened"
+	Action DependabotAlertAction `json:"action"`
+	Alert  struct {
+		Number uint32 `json:"number"`
+		// "dissmissed", "fixed", "open"
+		State      string `json:"state"`
+		Dependency struct {
+			Package struct {
+				Ecosystem string `json:"ecosystem"`
+				Name      string `json:"name"`
+			} `json:"package"`
+			ManifestPath string `json:"manifest_path"`
+			// "development", "runtime", null
+			Scope string `json:"scope"`
+		} `json:"dependency"`
+		SecurityAdvisory struct {
+			GHSAID          string `json:"ghsa_id"`
+			CVEID           string `json:"cve_id"`
+			Summary         string `json:"summary"`
+			Description     string `json:"description"`
+			Vulnerabilities []struct {
+				Package struct {
+					Ecosystem string `json:"ecosystem"`
+					Name      string `json:"name"`
+				} `json:"package"`
+				// "low", "midium", "high", "critical"
+				Severity               string `json:"severity"`
+				VulnerableVersionRange string `json:"vulnerable_version_range"`
+				FirstPatchedVersion    struct {
+					Identifier string `json:"identifier"`
+				} `json:"first_patched_version"`
+			} `json:"vulnerabilities"`
+			// "low", "medium", "high", "critical"
+			Severity string `json:"severity"`
+			CVSS     struct {
+				Score        float64 `json:"score"`
+				VectorString string  `json:"vector_string"`
+			} `json:"cvss"`
+			CWEs []struct {
+				CWEID string `json:"cwe_id"`
+				Name  string `json:"name"`
+			} `json:"cwes"`
+			Identifiers []struct {
+				// "CVE", "GHSA"
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"identifiers"`
+			References []struct {
+				URL string `json:"url"`
+			} `json:"references"`
+			PublishedAt Timestamp `json:"published_at"`
+			UpdatedAt   Timestamp `json:"updated_at"`
+			WithdrawnAt Timestamp `json:"withdrawn_at"`
+		} `json:"security_advisory"`
+		SecurityVulnerability struct {
+			Package struct {
+				Ecosystem string `json:"ecosystem"`
+				Name      string `json:"name"`
+			} `json:"package"`
+			// "low", "medium", "high", "critical"
+			Severity               string `json:"severity"`
+			VulnerableVersionRange string `json:"vulnerable_version_range"`
+			FirstPatchedVersion    struct {
+				Identifier string `json:"identifier"`
+			} `json:"first_patched_version"`
+		} `json:"secirty_vulnerability"`
+		URL          string    `json:"url"`
+		HTMLURL      string    `json:"html_url"`
+		CreatedAt    Timestamp `json:"created_at"`
+		UpdatedAt    Timestamp `json:"updated_at"`
+		DissmissedAt Timestamp `json:"dissmissed_at"`
+		DissmissedBy struct {
+			Name              string    `json:"name"`
+			Email             string    `json:"email"`
+			Login             string    `json:"login"`
+			ID                uint64    `json:"id"`
+			NodeID            string    `json:"node_id"`
+			AvatarURL         string    `json:"avatar_url"`
+			GravatarID        string    `json:"gravatar_id"`
+			URL               string    `json:"url"`
+			HTMLURL           string    `json:"html_url"`
+			FollowersURL      string    `json:"followers_url"`
+			GistsURL          string    `json:"gists_url"`
+			StarredURL        string    `json:"starred_url"`
+			SubscriptionsURL  string    `json:"subscriptions_url"`
+			OrganizationsURL  string    `json:"organizations_url"`
+			ReposURL          string    `json:"repos_url"`
+			EventsURL         string    `json:"events_url"`
+			ReceivedEventsURL string    `json:"received_events_url"`
+			Type              string    `json:"type"`
+			SiteAdmin         bool      `json:"site_admin"`
+			StarredAt         Timestamp `json:"starred_at"`
+		} `json:"dissmissed_by"`
+		// "fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk", null
+		DissmissedReason  string    `json:"dissmissed_reason"`
+		DissmissedComment string    `json:"dissmissed_comment"`
+		FixedAt           Timestamp `json:"fixed_at"`
+	} `json:"alert"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// DependabotAlertAction is the action of the dependabot_alert event
+type DependabotAlertAction string
+
+// dependabot_alert actions
+const (
+	DependabotAlertActionAutoDismissed DependabotAlertAction = "auto_dismissed"
+	DependabotAlertActionAutoReopened  DependabotAlertAction = "auto_reopened"
+	DependabotAlertActionCreated       DependabotAlertAction = "created"
+	DependabotAlertActionDismissed     DependabotAlertAction = "dismissed"
+	DependabotAlertActionFixed         DependabotAlertAction = "fixed"
+	DependabotAlertActionReintroduced  DependabotAlertAction = "reintroduced"
+	DependabotAlertActionReopened      DependabotAlertAction = "reopened"
+)
+
+// Event returns the dependabot_alert event restricted to the action, for filtering it in Parse
+func (a DependabotAlertAction) Event() Event {
+	return DependabotAlertEvent.WithAction(string(a))
+}
+
+// DeployKeyPayload contains the information for GitHub's deploy_key hook event
+type DeployKeyPayload struct {
+	Action DeployKeyAction `json:"action"`
+	Key    struct {
+		ID        int       `json:"id"`
+		Key       string    `json:"key"`
+		URL       string    `json:"url"`
+		Title     string    `json:"title"`
+		Verified  bool      `json:"verified"`
+		CreatedAt Timestamp `json:"created_at"`
+		ReadOnly  bool      `json:"read_only"`
+	} `json:"key"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// DeployKeyAction is the action of the deploy_key event
+type DeployKeyAction string
+
+// deploy_key actions
+const (
+	DeployKeyActionCreated DeployKeyAction = "created"
+	DeployKeyActionDeleted DeployKeyAction = "deleted"
+)
+
+// Event returns the deploy_key event restricted to the action, for filtering it in Parse
+func (a DeployKeyAction) Event() Event {
+	return DeployKeyEvent.WithAction(string(a))
+}
+
+// DeploymentPayload contains the information for GitHub's deployment hook event
+type DeploymentPayload struct {
+	Action     DeploymentAction `json:"action,omitempty"`
+	Deployment struct {
+		URL           string    `json:"url"`
+		ID            int64     `json:"id"`
+		NodeID        string    `json:"node_id"`
+		Sha           string    `json:"sha"`
+		Ref           string    `json:"ref"`
+		Task          string    `json:"task"`
+		Payload       struct{}  `json:"payload"`
+		Environment   string    `json:"environment"`
+		Description   *string   `json:"description"`
+		Creator       User      `json:"creator"`
+		CreatedAt     Timestamp `json:"created_at"`
+		UpdatedAt     Timestamp `json:"updated_at"`
+		StatusesURL   string    `json:"statuses_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// DeploymentAction is the action of the deployment event
+type DeploymentAction string
+
+// deployment actions
+const (
+	DeploymentActionCreated DeploymentAction = "created"
+)
+
+// Event returns the deployment event restricted to the action, for filtering it in Parse
+func (a DeploymentAction) Event() Event {
+	return DeploymentEvent.WithAction(string(a))
+}
+
+// DeploymentProtectionRulePayload contains the information for GitHub's deployment_protection_rule hook event
+type DeploymentProtectionRulePayload struct {
+	Action                DeploymentProtectionRuleAction `json:"action"`
+	Environment           string                         `json:"environment"`
+	Event                 string                         `json:"event"`
+	Sha                   string                         `json:"sha"`
+	Ref                   string                         `json:"ref"`
+	DeploymentCallbackURL string                         `json:"deployment_callback_url"`
+	Deployment            struct {
+		URL                   string      `json:"url"`
+		ID                    int64       `json:"id"`
+		NodeID                string      `json:"node_id"`
+		Task                  string      `json:"task"`
+		OriginalEnvironment   string      `json:"original_environment"`
+		Environment           string      `json:"environment"`
+		Description           *string     `json:"description"`
+		CreatedAt             Timestamp   `json:"created_at"`
+		UpdatedAt             Timestamp   `json:"updated_at"`
+		StatusesURL           string      `json:"statuses_url"`
+		RepositoryURL         string      `json:"repository_url"`
+		Creator               User        `json:"creator"`
+		Sha                   string      `json:"sha"`
+		Ref                   string      `json:"ref"`
+		Payload               interface{} `json:"payload"`
+		TransientEnvironment  bool        `json:"transient_environment"`
+		ProductionEnvironment bool        `json:"production_environment"`
+	} `json:"deployment"`
+	PullRequests []struct {
+		URL    string            `json:"url"`
+		ID     int64             `json:"id"`
+		Number int64             `json:"number"`
+		Head   PullRequestBranch `json:"head"`
+		Base   PullRequestBranch `json:"base"`
+	} `json:"pull_requests"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// DeploymentProtectionRuleAction is the action of the deployment_protection_rule event
+type DeploymentProtectionRuleAction string
+
+// deployment_protection_rule actions
+const (
+	DeploymentProtectionRuleActionRequested DeploymentProtectionRuleAction = "requested"
+)
+
+// Event returns the deployment_protection_rule event restricted to the action, for filtering it in Parse
+func (a DeploymentProtectionRuleAction) Event() Event {
+	return DeploymentProtectionRuleEvent.WithAction(string(a))
+}
+
+// DeploymentReviewPayload contains the information for GitHub's deployment_review hook event
+type DeploymentReviewPayload struct {
+	Action         DeploymentReviewAction `json:"action"`
+	Approver       *User                  `json:"approver,omitempty"`
+	Comment        string                 `json:"comment,omitempty"`
+	WorkflowJobRun *struct {
+		Conclusion  *string   `json:"conclusion"`
+		CreatedAt   Timestamp `json:"created_at"`
+		Environment string    `json:"environment"`
+		HTMLURL     string    `json:"html_url"`
+		ID          int64     `json:"id"`
+		Name        *string   `json:"name"`
+		Status      string    `json:"status"`
+		UpdatedAt   Timestamp `json:"updated_at"`
+	} `json:"workflow_job_run,omitempty"`
+	WorkflowJobRuns []struct {
+		Conclusion  *string   `json:"conclusion"`
+		CreatedAt   Timestamp `json:"created_at"`
+		Environment string    `json:"environment"`
+		HTMLURL     string    `json:"html_url"`
+		ID          int64     `json:"id"`
+		Name        *string   `json:"name"`
+		Status      string    `json:"status"`
+		UpdatedAt   Timestamp `json:"updated_at"`
+	} `json:"workflow_job_runs,omitempty"`
+	Reviewers []struct {
+		Type     string `json:"type"`
+		Reviewer struct {
+			Login   string `json:"login,omitempty"`
+			Name    string `json:"name,omitempty"`
+			Slug    string `json:"slug,omitempty"`
+			ID      int64  `json:"id"`
+			NodeID  string `json:"node_id"`
+			URL     string `json:"url"`
+			HTMLURL string `json:"html_url"`
+			Type    string `json:"type,omitempty"`
+		} `json:"reviewer"`
+	} `json:"reviewers,omitempty"`
+	Requestor   *User     `json:"requestor,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+	Since       Timestamp `json:"since"`
+	WorkflowRun *struct {
+		ID               int64     `json:"id"`
+		Name             string    `json:"name"`
+		NodeID           string    `json:"node_id"`
+		HeadBranch       string    `json:"head_branch"`
+		HeadSha          string    `json:"head_sha"`
+		Path             string    `json:"path"`
+		DisplayTitle     string    `json:"display_title"`
+		RunNumber        int64     `json:"run_number"`
+		RunAttempt       int64     `json:"run_attempt"`
+		Event            string    `json:"event"`
+		Status           string    `json:"status"`
+		Conclusion       *string   `json:"conclusion"`
+		WorkflowID       int64     `json:"workflow_id"`
+		CheckSuiteID     int64     `json:"check_suite_id"`
+		CheckSuiteNodeID string    `json:"check_suite_node_id"`
+		URL              string    `json:"url"`
+		HTMLURL          string    `json:"html_url"`
+		CreatedAt        Timestamp `json:"created_at"`
+		UpdatedAt        Timestamp `json:"updated_at"`
+		RunStartedAt     Timestamp `json:"run_started_at"`
+		JobsURL          string    `json:"jobs_url"`
+		LogsURL          string    `json:"logs_url"`
+		CheckSuiteURL    string    `json:"check_suite_url"`
+		ArtifactsURL     string    `json:"artifacts_url"`
+		CancelURL        string    `json:"cancel_url"`
+		RerunURL         string    `json:"rerun_url"`
+		WorkflowURL      string    `json:"workflow_url"`
+		Actor            User      `json:"actor"`
+		TriggeringActor  User      `json:"triggering_actor"`
+	} `json:"workflow_run"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// DeploymentReviewAction is the action of the deployment_review event
+type DeploymentReviewAction string
+
+// deployment_review actions
+const (
+	DeploymentReviewActionApproved  DeploymentReviewAction = "approved"
+	DeploymentReviewActionRejected  DeploymentReviewAction = "rejected"
+	DeploymentReviewActionRequested DeploymentReviewAction = "requested"
+)
+
+// Event returns the deployment_review event restricted to the action, for filtering it in Parse
+func (a DeploymentReviewAction) Event() Event {
+	return DeploymentReviewEvent.WithAction(string(a))
+}
+
+// DeploymentStatusPayload contains the information for GitHub's deployment_status hook event
+type DeploymentStatusPayload struct {
+	Action           DeploymentStatusAction `json:"action,omitempty"`
+	DeploymentStatus struct {
+		URL           string    `json:"url"`
+		ID            int64     `json:"id"`
+		NodeID        string    `json:"node_id"`
+		State         string    `json:"state"`
+		Creator       User      `json:"creator"`
+		Description   *string   `json:"description"`
+		TargetURL     *string   `json:"target_url"`
+		CreatedAt     Timestamp `json:"created_at"`
+		UpdatedAt     Timestamp `json:"updated_at"`
+		DeploymentURL string    `json:"deployment_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment_status"`
+	Deployment struct {
+		URL           string    `json:"url"`
+		ID            int64     `json:"id"`
+		NodeID        string    `json:"node_id"`
+		Sha           string    `json:"sha"`
+		Ref           string    `json:"ref"`
+		Task          string    `json:"task"`
+		Payload       struct{}  `json:"payload"`
+		Environment   string    `json:"environment"`
+		Description   *string   `json:"description"`
+		Creator       User      `json:"creator"`
+		CreatedAt     Timestamp `json:"created_at"`
+		UpdatedAt     Timestamp `json:"updated_at"`
+		StatusesURL   string    `json:"statuses_url"`
+		RepositoryURL string    `json:"repository_url"`
+	} `json:"deployment"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// DeploymentStatusAction is the action of the deployment_status event
+type DeploymentStatusAction string
+
+// deployment_status actions
+const (
+	DeploymentStatusActionCreated DeploymentStatusAction = "created"
+)
+
+// Event returns the deployment_status event restricted to the action, for filtering it in Parse
+func (a DeploymentStatusAction) Event() Event {
+	return DeploymentStatusEvent.WithAction(string(a))
+}
+
+// DiscussionPayload contains the information for GitHub's discussion hook event
+type DiscussionPayload struct {
+	Action     DiscussionAction `json:"action"`
+	Discussion struct {
+		RepositoryURL string `json:"repository_url"`
+		Category      struct {
+			ID           int64     `json:"id"`
+			NodeID       string    `json:"node_id"`
+			RepositoryID int64     `json:"repository_id"`
+			Emoji        string    `json:"emoji"`
+			Name         string    `json:"name"`
+			Description  string    `json:"description"`
+			CreatedAt    Timestamp `json:"created_at"`
+			UpdatedAt    Timestamp `json:"updated_at"`
+			Slug         string    `json:"slug"`
+			IsAnswerable bool      `json:"is_answerable"`
+		} `json:"category"`
+		AnswerHTMLURL     *string    `json:"answer_html_url"`
+		AnswerChosenAt    *Timestamp `json:"answer_chosen_at"`
+		AnswerChosenBy    *User      `json:"answer_chosen_by"`
+		HTMLURL           string     `json:"html_url"`
+		ID                int64      `json:"id"`
+		NodeID            string     `json:"node_id"`
+		Number            int64      `json:"number"`
+		Title             string     `json:"title"`
+		User              User       `json:"user"`
+		Labels            []Label    `json:"labels"`
+		State             string     `json:"state"`
+		StateReason       *string    `json:"state_reason"`
+		Locked            bool       `json:"locked"`
+		Comments          int64      `json:"comments"`
+		CreatedAt         Timestamp  `json:"created_at"`
+		UpdatedAt         Timestamp  `json:"updated_at"`
+		AuthorAssociation string     `json:"author_association"`
+		ActiveLockReason  *string    `json:"active_lock_reason"`
+		Body              string     `json:"body"`
+		Reactions         Reactions  `json:"reactions"`
+		TimelineURL       string     `json:"timeline_url"`
+	} `json:"discussion"`
+	Answer *struct {
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		HTMLURL           string    `json:"html_url"`
+		ParentID          *int64    `json:"parent_id"`
+		ChildCommentCount int64     `json:"child_comment_count"`
+		RepositoryURL     string    `json:"repository_url"`
+		DiscussionID      int64     `json:"discussion_id"`
+		AuthorAssociation string    `json:"author_association"`
+		User              User      `json:"user"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		Body              string    `json:"body"`
+		Reactions         Reactions `json:"reactions"`
+	} `json:"answer,omitempty"`
+	OldAnswer *struct {
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		HTMLURL           string    `json:"html_url"`
+		ParentID          *int64    `json:"parent_id"`
+		ChildCommentCount int64     `json:"child_comment_count"`
+		RepositoryURL     string    `json:"repository_url"`
+		DiscussionID      int64     `json:"discussion_id"`
+		AuthorAssociation string    `json:"author_association"`
+		User              User      `json:"user"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		Body              string    `json:"body"`
+	} `json:"old_answer,omitempty"`
+	Label   *Label `json:"label,omitempty"`
+	Changes *struct {
+		Title *struct {
+			From string `json:"from"`
+		} `json:"title,omitempty"`
+		Body *struct {
+			From string `json:"from"`
+		} `json:"body,omitempty"`
+		Category *struct {
+			From struct {
+				ID           int64     `json:"id"`
+				NodeID       string    `json:"node_id"`
+				RepositoryID int64     `json:"repository_id"`
+				Emoji        string    `json:"emoji"`
+				Name         string    `json:"name"`
+				Description  string    `json:"description"`
+				CreatedAt    Timestamp `json:"created_at"`
+				UpdatedAt    Timestamp `json:"updated_at"`
+				Slug         string    `json:"slug"`
+				IsAnswerable bool      `json:"is_answerable"`
+			} `json:"from"`
+		} `json:"category,omitempty"`
+		NewDiscussion *struct {
+			HTMLURL string `json:"html_url"`
+			ID      int64  `json:"id"`
+			NodeID  string `json:"node_id"`
+			Number  int64  `json:"number"`
+			Title   string `json:"title"`
+		} `json:"new_discussion,omitempty"`
+		NewRepository *Repository `json:"new_repository"`
+	} `json:"changes,omitempty"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// DiscussionAction is the action of the discussion event
+type DiscussionAction string
+
+// discussion actions
+const (
+	DiscussionActionAnswered        DiscussionAction = "answered"
+	DiscussionActionCategoryChanged DiscussionAction = "category_changed"
+	DiscussionActionClosed          DiscussionAction = "closed"
+	DiscussionActionCreated         DiscussionAction = "created"
+	DiscussionActionDeleted         DiscussionAction = "deleted"
+	DiscussionActionEdited          DiscussionAction = "edited"
+	DiscussionActionLabeled         DiscussionAction = "labeled"
+	DiscussionActionLocked          DiscussionAction = "locked"
+	DiscussionActionPinned          DiscussionAction = "pinned"
+	DiscussionActionReopened        DiscussionAction = "reopened"
+	DiscussionActionTransferred     DiscussionAction = "transferred"
+	DiscussionActionUnanswered      DiscussionAction = "unanswered"
+	DiscussionActionUnlabeled       DiscussionAction = "unlabeled"
+	DiscussionActionUnlocked        DiscussionAction = "unlocked"
+	DiscussionActionUnpinned        DiscussionAction = "unpinned"
+)
+
+// Event returns the discussion event restricted to the action, for filtering it in Parse
+func (a DiscussionAction) Event() Event {
+	return DiscussionEvent.WithAction(string(a))
+}
+
+// DiscussionCommentPayload contains the information for GitHub's discussion_comment hook event
+type DiscussionCommentPayload struct {
+	Action  DiscussionCommentAction `json:"action"`
+	Comment struct {
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		HTMLURL           string    `json:"html_url"`
+		ParentID          *int64    `json:"parent_id"`
+		ChildCommentCount int64     `json:"child_comment_count"`
+		RepositoryURL     string    `json:"repository_url"`
+		DiscussionID      int64     `json:"discussion_id"`
+		AuthorAssociation string    `json:"author_association"`
+		User              User      `json:"user"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		Body              string    `json:"body"`
+		Reactions         Reactions `json:"reactions"`
+	} `json:"comment"`
+	Discussion struct {
+		RepositoryURL string `json:"repository_url"`
+		Category      struct {
+			ID           int64     `json:"id"`
+			NodeID       string    `json:"node_id"`
+			RepositoryID int64     `json:"repository_id"`
+			Emoji        string    `json:"emoji"`
+			Name         string    `json:"name"`
+			Description  string    `json:"description"`
+			CreatedAt    Timestamp `json:"created_at"`
+			UpdatedAt    Timestamp `json:"updated_at"`
+			Slug         string    `json:"slug"`
+			IsAnswerable bool      `json:"is_answerable"`
+		} `json:"category"`
+		AnswerHTMLURL     *string    `json:"answer_html_url"`
+		AnswerChosenAt    *Timestamp `json:"answer_chosen_at"`
+		AnswerChosenBy    *User      `json:"answer_chosen_by"`
+		HTMLURL           string     `json:"html_url"`
+		ID                int64      `json:"id"`
+		NodeID            string     `json:"node_id"`
+		Number            int64      `json:"number"`
+		Title             string     `json:"title"`
+		User              User       `json:"user"`
+		Labels            []Label    `json:"labels"`
+		State             string     `json:"state"`
+		StateReason       *string    `json:"state_reason"`
+		Locked            bool       `json:"locked"`
+		Comments          int64      `json:"comments"`
+		CreatedAt         Timestamp  `json:"created_at"`
+		UpdatedAt         Timestamp  `json:"updated_at"`
+		AuthorAssociation string     `json:"author_association"`
+		ActiveLockReason  *string    `json:"active_lock_reason"`
+		Body              string     `json:"body"`
+		Reactions         Reactions  `json:"reactions"`
+		TimelineURL       string     `json:"timeline_url"`
+	} `json:"discussion"`
+	Changes *struct {
+		Body *struct {
+			From string `json:"from"`
+		} `json:"body,omitempty"`
+	} `json:"changes,omitempty"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// DiscussionCommentAction is the action of the discussion_comment event
+type DiscussionCommentAction string
+
+// discussion_comment actions
+const (
+	DiscussionCommentActionCreated DiscussionCommentAction = "created"
+	DiscussionCommentActionDeleted DiscussionCommentAction = "deleted"
+	DiscussionCommentActionEdited  DiscussionCommentAction = "edited"
+)
+
+// Event returns the discussion_comment event restricted to the action, for filtering it in Parse
+func (a DiscussionCommentAction) Event() Event {
+	return DiscussionCommentEvent.WithAction(string(a))
+}
+
+// EnterprisePayload contains the information for GitHub's enterprise hook event
+type EnterprisePayload struct {
+	Action       EnterpriseAction `json:"action"`
+	Enterprise   *Enterprise      `json:"enterprise,omitempty"`
+	Sender       User             `json:"sender"`
+	Installation Installation     `json:"installation,omitempty"`
+}
+
+// EnterpriseAction is the action of the enterprise event
+type EnterpriseAction string
+
+// enterprise actions
+const (
+	EnterpriseActionAnonymousAccessDisabled EnterpriseAction = "anonymous_access_disabled"
+	EnterpriseActionAnonymousAccessEnabled  EnterpriseAction = "anonymous_access_enabled"
+)
+
+// Event returns the enterprise event restricted to the action, for filtering it in Parse
+func (a EnterpriseAction) Event() Event {
+	return EnterpriseEvent.WithAction(string(a))
+}
+
+// ForkPayload contains the information for GitHub's fork hook event
+type ForkPayload struct {
+	Forkee       Repository    `json:"forkee"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// GitHubAppAuthorizationPayload contains the information for GitHub's github_app_authorization hook event
+type GitHubAppAuthorizationPayload struct {
+	Action GitHubAppAuthorizationAction `json:"action"`
+	Sender User                         `json:"sender"`
+}
+
+// GitHubAppAuthorizationAction is the action of the github_app_authorization event
+type GitHubAppAuthorizationAction string
+
+// github_app_authorization actions
+const (
+	GitHubAppAuthorizationActionRevoked GitHubAppAuthorizationAction = "revoked"
+)
+
+// Event returns the github_app_authorization event restricted to the action, for filtering it in Parse
+func (a GitHubAppAuthorizationAction) Event() Event {
+	return GitHubAppAuthorizationEvent.WithAction(string(a))
+}
+
+// GollumPayload contains the information for GitHub's gollum hook event
+type GollumPayload struct {
+	Pages []struct {
+		PageName string  `json:"page_name"`
+		Title    string  `json:"title"`
+		Summary  *string `json:"summary"`
+		Action   string  `json:"action"`
+		Sha      string  `json:"sha"`
+		HTMLURL  string  `json:"html_url"`
+	} `json:"pages"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// InstallationPayload contains the information for GitHub's installation hook event
+type InstallationPayload struct {
+	Action       InstallationAction `json:"action"`
+	Installation struct {
+		ID                  int64  `json:"id"`
+		NodeID              string `json:"node_id"`
+		Account             User   `json:"account"`
+		RepositorySelection string `json:"repository_selection"`
+		AccessTokensURL     string `json:"access_tokens_url"`
+		RepositoriesURL     string `json:"repositories_url"`
+		HTMLURL             string `json:"html_url"`
+		AppID               int    `json:"app_id"`
+		TargetID            int    `json:"target_id"`
+		TargetType          string `json:"target_type"`
+		Permissions         struct {
+			Issues             string `json:"issues"`
+			Metadata           string `json:"metadata"`
+			PullRequests       string `json:"pull_requests"`
+			RepositoryProjects string `json:"repository_projects"`
+		} `json:"permissions"`
+		Events         []string  `json:"events"`
+		CreatedAt      Timestamp `json:"created_at"`
+		UpdatedAt      Timestamp `json:"updated_at"`
+		SingleFileName *string   `json:"single_file_name"`
+	} `json:"installation"`
+	Repositories []struct {
+		ID       int64  `json:"id"`
+		NodeID   string `json:"node_id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Private  bool   `json:"private"`
+	} `json:"repositories"`
+	Sender     User        `json:"sender"`
+	Enterprise *Enterprise `json:"enterprise,omitempty"`
+}
+
+// InstallationAction is the action of the installation event
+type InstallationAction string
+
+// installation actions
+const (
+	InstallationActionCreated                InstallationAction = "created"
+	InstallationActionDeleted                InstallationAction = "deleted"
+	InstallationActionNewPermissionsAccepted InstallationAction = "new_permissions_accepted"
+	InstallationActionSuspend                InstallationAction = "suspend"
+	InstallationActionUnsuspend              InstallationAction = "unsuspend"
+)
+
+// Event returns the installation event restricted to the action, for filtering it in Parse
+func (a InstallationAction) Event() Event {
+	return InstallationEvent.WithAction(string(a))
+}
+
+// InstallationRepositoriesPayload contains the information for GitHub's installation_repositories hook event
+type InstallationRepositoriesPayload struct {
+	Action       InstallationRepositoriesAction `json:"action"`
+	Installation struct {
+		ID                  int64  `json:"id"`
+		NodeID              string `json:"node_id"`
+		Account             User   `json:"account"`
+		RepositorySelection string `json:"repository_selection"`
+		AccessTokensURL     string `json:"access_tokens_url"`
+		RepositoriesURL     string `json:"repositories_url"`
+		HTMLURL             string `json:"html_url"`
+		AppID               int    `json:"app_id"`
+		TargetID            int    `json:"target_id"`
+		TargetType          string `json:"target_type"`
+		Permissions         struct {
+			Issues              string `json:"issues"`
+			Metadata            string `json:"metadata"`
+			PullRequests        string `json:"pull_requests"`
+			RepositoryProjects  string `json:"repository_projects"`
+			VulnerabilityAlerts string `json:"vulnerability_alerts"`
+			Statuses            string `json:"statuses"`
+			Administration      string `json:"administration"`
+			Deployments         string `json:"deployments"`
+			Contents            string `json:"contents"`
+		} `json:"permissions"`
+		Events         []string  `json:"events"`
+		CreatedAt      Timestamp `json:"created_at"`
+		UpdatedAt      Timestamp `json:"updated_at"`
+		SingleFileName *string   `json:"single_file_name"`
+	} `json:"installation"`
+	RepositoriesAdded []struct {
+		ID       int64  `json:"id"`
+		NodeID   string `json:"node_id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Private  bool   `json:"private"`
+	} `json:"repositories_added"`
+	RepositoriesRemoved []struct {
+		ID       int64  `json:"id"`
+		NodeID   string `json:"node_id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+		Private  bool   `json:"private"`
+	} `json:"repositories_removed"`
+	Sender     User        `json:"sender"`
+	Enterprise *Enterprise `json:"enterprise,omitempty"`
+}
+
+// InstallationRepositoriesAction is the action of the installation_repositories event
+type InstallationRepositoriesAction string
+
+// installation_repositories actions
+const (
+	InstallationRepositoriesActionAdded   InstallationRepositoriesAction = "added"
+	InstallationRepositoriesActionRemoved InstallationRepositoriesAction = "removed"
+)
+
+// Event returns the installation_repositories event restricted to the action, for filtering it in Parse
+func (a InstallationRepositoriesAction) Event() Event {
+	return InstallationRepositoriesEvent.WithAction(string(a))
+}
+
+// InstallationTargetPayload contains the information for GitHub's installation_target hook event
+type InstallationTargetPayload struct {
+	Action  InstallationTargetAction `json:"action"`
+	Account struct {
+		ID                      int64      `json:"id"`
+		NodeID                  string     `json:"node_id"`
+		Login                   string     `json:"login,omitempty"`
+		Slug                    string     `json:"slug,omitempty"`
+		Name                    string     `json:"name,omitempty"`
+		Description             *string    `json:"description,omitempty"`
+		Type                    string     `json:"type,omitempty"`
+		AvatarURL               string     `json:"avatar_url"`
+		GravatarID              string     `json:"gravatar_id,omitempty"`
+		URL                     string     `json:"url,omitempty"`
+		HTMLURL                 string     `json:"html_url"`
+		WebsiteURL              *string    `json:"website_url,omitempty"`
+		EventsURL               string     `json:"events_url,omitempty"`
+		HooksURL                string     `json:"hooks_url,omitempty"`
+		IssuesURL               string     `json:"issues_url,omitempty"`
+		MembersURL              string     `json:"members_url,omitempty"`
+		PublicMembersURL        string     `json:"public_members_url,omitempty"`
+		ReposURL                string     `json:"repos_url,omitempty"`
+		Followers               int64      `json:"followers,omitempty"`
+		Following               int64      `json:"following,omitempty"`
+		PublicGists             int64      `json:"public_gists,omitempty"`
+		PublicRepos             int64      `json:"public_repos,omitempty"`
+		IsVerified              bool       `json:"is_verified,omitempty"`
+		HasOrganizationProjects bool       `json:"has_organization_projects,omitempty"`
+		HasRepositoryProjects   bool       `json:"has_repository_projects,omitempty"`
+		SiteAdmin               bool       `json:"site_admin,omitempty"`
+		CreatedAt               Timestamp  `json:"created_at,omitempty"`
+		UpdatedAt               Timestamp  `json:"updated_at,omitempty"`
+		ArchivedAt              *Timestamp `json:"archived_at,omitempty"`
+	} `json:"account"`
+	Changes struct {
+		Login struct {
+			From string `json:"from"`
+		} `json:"login,omitempty"`
+		Slug struct {
+			From string `json:"from"`
+		} `json:"slug,omitempty"`
+	} `json:"changes"`
+	TargetType   string       `json:"target_type"`
+	Enterprise   Enterprise   `json:"enterprise,omitempty"`
+	Installation Installation `json:"installation,omitempty"`
+	Organization Organization `json:"organization,omitempty"`
+	Repository   Repository   `json:"repository,omitempty"`
+	Sender       User         `json:"sender,omitempty"`
+}
+
+// InstallationTargetAction is the action of the installation_target event
+type InstallationTargetAction string
+
+// installation_target actions
+const (
+	InstallationTargetActionRenamed InstallationTargetAction = "renamed"
+)
+
+// Event returns the installation_target event restricted to the action, for filtering it in Parse
+func (a InstallationTargetAction) Event() Event {
+	return InstallationTargetEvent.WithAction(string(a))
+}
+
+// IssueCommentPayload contains the information for GitHub's issue_comment hook event
+type IssueCommentPayload struct {
+	Action  IssueCommentAction `json:"action"`
+	Issue   Issue              `json:"issue"`
+	Comment struct {
+		URL               string    `json:"url"`
+		HTMLURL           string    `json:"html_url"`
+		IssueURL          string    `json:"issue_url"`
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		User              User      `json:"user"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		Body              string    `json:"body"`
+		Reactions         Reactions `json:"reactions,omitempty"`
+		AuthorAssociation string    `json:"author_association"`
+	} `json:"comment"`
+	Changes *struct {
+		Body *struct {
+			From string `json:"from"`
+		} `json:"body"`
+	} `json:"changes,omitempty"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// IssueCommentAction is the action of the issue_comment event
+type IssueCommentAction string
+
+// issue_comment actions
+const (
+	IssueCommentActionCreated IssueCommentAction = "created"
+	IssueCommentActionDeleted IssueCommentAction = "deleted"
+	IssueCommentActionEdited  IssueCommentAction = "edited"
+)
+
+// Event returns the issue_comment event restricted to the action, for filtering it in Parse
+func (a IssueCommentAction) Event() Event {
+	return IssueCommentEvent.WithAction(string(a))
+}
+
+// IssueDependenciesPayload contains the information for GitHub's issue_dependencies hook event
+type IssueDependenciesPayload struct {
+	Action            IssueDependenciesAction `json:"action"`
+	BlockedIssueID    int64                   `json:"blocked_issue_id"`
+	BlockedIssue      Issue                   `json:"blocked_issue"`
+	BlockingIssueID   int64                   `json:"blocking_issue_id"`
+	BlockingIssue     Issue                   `json:"blocking_issue"`
+	BlockingIssueRepo *Repository             `json:"blocking_issue_repo"`
+	Repository        Repository              `json:"repository"`
+	Organization      Organization            `json:"organization,omitempty"`
+	Sender            User                    `json:"sender"`
+	Installation      Installation            `json:"installation,omitempty"`
+	Enterprise        *Enterprise             `json:"enterprise,omitempty"`
+}
+
+// IssueDependenciesAction is the action of the issue_dependencies event
+type IssueDependenciesAction string
+
+// issue_dependencies actions
+const (
+	IssueDependenciesActionBlockedByAdded   IssueDependenciesAction = "blocked_by_added"
+	IssueDependenciesActionBlockedByRemoved IssueDependenciesAction = "blocked_by_removed"
+	IssueDependenciesActionBlockingAdded    IssueDependenciesAction = "blocking_added"
+	IssueDependenciesActionBlockingRemoved  IssueDependenciesAction = "blocking_removed"
+)
+
+// Event returns the issue_dependencies event restricted to the action, for filtering it in Parse
+func (a IssueDependenciesAction) Event() Event {
+	return IssueDependenciesEvent.WithAction(string(a))
+}
+
+// IssuesPayload contains the information for GitHub's issues hook event
+type IssuesPayload struct {
+	Action  IssuesAction `json:"action"`
+	Issue   Issue        `json:"issue"`
+	Changes *struct {
+		Title *struct {
+			From string `json:"from"`
+		} `json:"title"`
+		Body *struct {
+			From string `json:"from"`
+		} `json:"body"`
+	} `json:"changes,omitempty"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Assignee     *User         `json:"assignee,omitempty"`
+	Label        *Label        `json:"label,omitempty"`
+	Type         *IssueType    `json:"type,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// IssuesAction is the action of the issues event
+type IssuesAction string
+
+// issues actions
+const (
+	IssuesActionAssigned     IssuesAction = "assigned"
+	IssuesActionClosed       IssuesAction = "closed"
+	IssuesActionDeleted      IssuesAction = "deleted"
+	IssuesActionDemilestoned IssuesAction = "demilestoned"
+	IssuesActionEdited       IssuesAction = "edited"
+	IssuesActionLabeled      IssuesAction = "labeled"
+	IssuesActionLocked       IssuesAction = "locked"
+	IssuesActionMilestoned   IssuesAction = "milestoned"
+	IssuesActionOpened       IssuesAction = "opened"
+	IssuesActionPinned       IssuesAction = "pinned"
+	IssuesActionReopened     IssuesAction = "reopened"
+	IssuesActionTransferred  IssuesAction = "transferred"
+	IssuesActionTyped        IssuesAction = "typed"
+	IssuesActionUnassigned   IssuesAction = "unassigned"
+	IssuesActionUnlabeled    IssuesAction = "unlabeled"
+	IssuesActionUnlocked     IssuesAction = "unlocked"
+	IssuesActionUnpinned     IssuesAction = "unpinned"
+	IssuesActionUntyped      IssuesAction = "untyped"
+)
+
+// Event returns the issues event restricted to the action, for filtering it in Parse
+func (a IssuesAction) Event() Event {
+	return IssuesEvent.WithAction(string(a))
+}
+
+// LabelPayload contains the information for GitHub's label hook event
+type LabelPayload struct {
+	Action       LabelAction   `json:"action"`
+	Label        Label         `json:"label"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// LabelAction is the action of the label event
+type LabelAction string
+
+// label actions
+const (
+	LabelActionCreated LabelAction = "created"
+	LabelActionDeleted LabelAction = "deleted"
+	LabelActionEdited  LabelAction = "edited"
+)
+
+// Event returns the label event restricted to the action, for filtering it in Parse
+func (a LabelAction) Event() Event {
+	return LabelEvent.WithAction(string(a))
+}
+
+// MarketplacePurchasePayload contains the information for GitHub's marketplace_purchase hook event
+type MarketplacePurchasePayload struct {
+	Action                      MarketplacePurchaseAction `json:"action"`
+	EffectiveDate               Timestamp                 `json:"effective_date"`
+	MarketplacePurchase         MarketplacePurchase       `json:"marketplace_purchase"`
+	PreviousMarketplacePurchase *MarketplacePurchase      `json:"previous_marketplace_purchase,omitempty"`
+	Sender                      User                      `json:"sender"`
+	Installation                Installation              `json:"installation,omitempty"`
+}
+
+// MarketplacePurchaseAction is the action of the marketplace_purchase event
+type MarketplacePurchaseAction string
+
+// marketplace_purchase actions
+const (
+	MarketplacePurchaseActionCancelled              MarketplacePurchaseAction = "cancelled"
+	MarketplacePurchaseActionChanged                MarketplacePurchaseAction = "changed"
+	MarketplacePurchaseActionPendingChange          MarketplacePurchaseAction = "pending_change"
+	MarketplacePurchaseActionPendingChangeCancelled MarketplacePurchaseAction = "pending_change_cancelled"
+	MarketplacePurchaseActionPurchased              MarketplacePurchaseAction = "purchased"
+)
+
+// Event returns the marketplace_purchase event restricted to the action, for filtering it in Parse
+func (a MarketplacePurchaseAction) Event() Event {
+	return MarketplacePurchaseEvent.WithAction(string(a))
+}
+
+// MemberPayload contains the information for GitHub's member hook event
+type MemberPayload struct {
+	Action       MemberAction  `json:"action"`
+	Member       User          `json:"member"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// MemberAction is the action of the member event
+type MemberAction string
+
+// member actions
+const (
+	MemberActionAdded   MemberAction = "added"
+	MemberActionEdited  MemberAction = "edited"
+	MemberActionRemoved MemberAction = "removed"
+)
+
+// Event returns the member event restricted to the action, for filtering it in Parse
+func (a MemberAction) Event() Event {
+	return MemberEvent.WithAction(string(a))
+}
+
+// MembershipPayload contains the information for GitHub's membership hook event
+type MembershipPayload struct {
+	Action       MembershipAction `json:"action"`
+	Scope        string           `json:"scope"`
+	Member       User             `json:"member"`
+	Sender       User             `json:"sender"`
+	Team         *Team            `json:"team"`
+	Organization Organization     `json:"organization"`
+	Installation *Installation    `json:"installation,omitempty"`
+	Enterprise   *Enterprise      `json:"enterprise,omitempty"`
+}
+
+// MembershipAction is the action of the membership event
+type MembershipAction string
+
+// membership actions
+const (
+	MembershipActionAdded   MembershipAction = "added"
+	MembershipActionRemoved MembershipAction = "removed"
+)
+
+// Event returns the membership event restricted to the action, for filtering it in Parse
+func (a MembershipAction) Event() Event {
+	return MembershipEvent.WithAction(string(a))
+}
+
+// MergeGroupPayload contains the information for GitHub's merge_group hook event
+type MergeGroupPayload struct {
+	Action     MergeGroupAction `json:"action"`
+	Reason     string           `json:"reason,omitempty"`
+	MergeGroup struct {
+		HeadSha    string `json:"head_sha"`
+		HeadRef    string `json:"head_ref"`
+		BaseSha    string `json:"base_sha"`
+		BaseRef    string `json:"base_ref"`
+		HeadCommit Commit `json:"head_commit"`
+	} `json:"merge_group"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// MergeGroupAction is the action of the merge_group event
+type MergeGroupAction string
+
+// merge_group actions
+const (
+	MergeGroupActionChecksRequested MergeGroupAction = "checks_requested"
+	MergeGroupActionDestroyed       MergeGroupAction = "destroyed"
+)
+
+// Event returns the merge_group event restricted to the action, for filtering it in Parse
+func (a MergeGroupAction) Event() Event {
+	return MergeGroupEvent.WithAction(string(a))
+}
+
+// MetaPayload contains the information for GitHub's meta hook event
+type MetaPayload struct {
+	Action MetaAction `json:"action"`
+	HookID int        `json:"hook_id"`
+	Hook   struct {
+		Type   string   `json:"type"`
+		ID     int64    `json:"id"`
+		NodeID string   `json:"node_id"`
+		Name   string   `json:"name"`
+		Active bool     `json:"active"`
+		Events []string `json:"events"`
+		AppID  int      `json:"app_id"`
+		Config struct {
+			ContentType string `json:"content_type"`
+			InsecureSSL string `json:"insecure_ssl"`
+			Secret      string `json:"secret"`
+			URL         string `json:"url"`
+		} `json:"config"`
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+	} `json:"hook"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// MetaAction is the action of the meta event
+type MetaAction string
+
+// meta actions
+const (
+	MetaActionDeleted MetaAction = "deleted"
+)
+
+// Event returns the meta event restricted to the action, for filtering it in Parse
+func (a MetaAction) Event() Event {
+	return MetaEvent.WithAction(string(a))
+}
+
+// MilestonePayload contains the information for GitHub's milestone hook event
+type MilestonePayload struct {
+	Action    MilestoneAction `json:"action"`
+	Milestone struct {
+		URL          string     `json:"url"`
+		HTMLURL      string     `json:"html_url"`
+		LabelsURL    string     `json:"labels_url"`
+		ID           int64      `json:"id"`
+		NodeID       string     `json:"node_id"`
+		Number       int64      `json:"number"`
+		Title        string     `json:"title"`
+		Description  *string    `json:"description"`
+		Creator      User       `json:"creator"`
+		OpenIssues   int64      `json:"open_issues"`
+		ClosedIssues int64      `json:"closed_issues"`
+		State        string     `json:"state"`
+		CreatedAt    Timestamp  `json:"created_at"`
+		UpdatedAt    Timestamp  `json:"updated_at"`
+		DueOn        *Timestamp `json:"due_on"`
+		ClosedAt     *Timestamp `json:"closed_at"`
+	} `json:"milestone"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// MilestoneAction is the action of the milestone event
+type MilestoneAction string
+
+// milestone actions
+const (
+	MilestoneActionClosed  MilestoneAction = "closed"
+	MilestoneActionCreated MilestoneAction = "created"
+	MilestoneActionDeleted MilestoneAction = "deleted"
+	MilestoneActionEdited  MilestoneAction = "edited"
+	MilestoneActionOpened  MilestoneAction = "opened"
+)
+
+// Event returns the milestone event restricted to the action, for filtering it in Parse
+func (a MilestoneAction) Event() Event {
+	return MilestoneEvent.WithAction(string(a))
+}
+
+// OrgBlockPayload contains the information for GitHub's org_block hook event
+type OrgBlockPayload struct {
+	Action       OrgBlockAction `json:"action"`
+	BlockedUser  User           `json:"blocked_user"`
+	Organization Organization   `json:"organization"`
+	Sender       User           `json:"sender"`
+	Installation *Installation  `json:"installation,omitempty"`
+	Enterprise   *Enterprise    `json:"enterprise,omitempty"`
+}
+
+// OrgBlockAction is the action of the org_block event
+type OrgBlockAction string
+
+// org_block actions
+const (
+	OrgBlockActionBlocked   OrgBlockAction = "blocked"
+	OrgBlockActionUnblocked OrgBlockAction = "unblocked"
+)
+
+// Event returns the org_block event restricted to the action, for filtering it in Parse
+func (a OrgBlockAction) Event() Event {
+	return OrgBlockEvent.WithAction(string(a))
+}
+
+// OrganizationPayload contains the information for GitHub's organization hook event
+type OrganizationPayload struct {
+	Action     OrganizationAction `json:"action"`
+	Invitation struct {
+		ID     int64   `json:"id"`
+		NodeID string  `json:"node_id"`
+		Login  string  `json:"login"`
+		Email  *string `json:"email"`
+		Role   string  `json:"role"`
+	} `json:"invitation"`
+	Membership struct {
+		URL             string `json:"url"`
+		State           string `json:"state"`
+		Role            string `json:"role"`
+		OrganizationURL string `json:"organization_url"`
+		User            User   `json:"user"`
+	} `json:"membership"`
+	Organization Organization  `json:"organization"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// OrganizationAction is the action of the organization event
+type OrganizationAction string
+
+// organization actions
+const (
+	OrganizationActionDeleted       OrganizationAction = "deleted"
+	OrganizationActionMemberAdded   OrganizationAction = "member_added"
+	OrganizationActionMemberInvited OrganizationAction = "member_invited"
+	OrganizationActionMemberRemoved OrganizationAction = "member_removed"
+	OrganizationActionRenamed       OrganizationAction = "renamed"
+)
+
+// Event returns the organization event restricted to the action, for filtering it in Parse
+func (a OrganizationAction) Event() Event {
+	return OrganizationEvent.WithAction(string(a))
+}
+
+// PackagePayload contains the information for GitHub's package hook event
+type PackagePayload struct {
+	Action       PackageAction `json:"action"`
+	Package      Package       `json:"package"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation Installation  `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PackageAction is the action of the package event
+type PackageAction string
+
+// package actions
+const (
+	PackageActionPublished PackageAction = "published"
+	PackageActionUpdated   PackageAction = "updated"
+)
+
+// Event returns the package event restricted to the action, for filtering it in Parse
+func (a PackageAction) Event() Event {
+	return PackageEvent.WithAction(string(a))
+}
+
+// PageBuildPayload contains the information for GitHub's page_build hook event
+type PageBuildPayload struct {
+	ID     int64  `json:"id"`
+	NodeID string `json:"node_id,omitempty"`
+	Build  struct {
+		URL    string `json:"url"`
+		Status string `json:"status"`
+		Error  struct {
+			Message *string `json:"message"`
+		} `json:"error"`
+		Pusher    User      `json:"pusher"`
+		Commit    string    `json:"commit"`
+		Duration  int64     `json:"duration"`
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+	} `json:"build"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PersonalAccessTokenRequestPayload contains the information for GitHub's personal_access_token_request hook event
+type PersonalAccessTokenRequestPayload struct {
+	Action                     PersonalAccessTokenRequestAction `json:"action"`
+	PersonalAccessTokenRequest struct {
+		ID                  int64                          `json:"id"`
+		Owner               User                           `json:"owner"`
+		PermissionsAdded    PersonalAccessTokenPermissions `json:"permissions_added"`
+		PermissionsUpgraded PersonalAccessTokenPermissions `json:"permissions_upgraded"`
+		PermissionsResult   PersonalAccessTokenPermissions `json:"permissions_result"`
+		RepositorySelection string                         `json:"repository_selection"`
+		RepositoryCount     *int64                         `json:"repository_count"`
+		Repositories        []struct {
+			FullName string `json:"full_name"`
+			ID       int64  `json:"id"`
+			Name     string `json:"name"`
+			NodeID   string `json:"node_id"`
+			Private  bool   `json:"private"`
+		} `json:"repositories"`
+		CreatedAt       Timestamp  `json:"created_at"`
+		TokenID         int64      `json:"token_id"`
+		TokenName       string     `json:"token_name"`
+		TokenExpired    bool       `json:"token_expired"`
+		TokenExpiresAt  *Timestamp `json:"token_expires_at"`
+		TokenLastUsedAt *Timestamp `json:"token_last_used_at"`
+	} `json:"personal_access_token_request"`
+	Organization Organization `json:"organization"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// PersonalAccessTokenRequestAction is the action of the personal_access_token_request event
+type PersonalAccessTokenRequestAction string
+
+// personal_access_token_request actions
+const (
+	PersonalAccessTokenRequestActionApproved  PersonalAccessTokenRequestAction = "approved"
+	PersonalAccessTokenRequestActionCancelled PersonalAccessTokenRequestAction = "cancelled"
+	PersonalAccessTokenRequestActionCreated   PersonalAccessTokenRequestAction = "created"
+	PersonalAccessTokenRequestActionDenied    PersonalAccessTokenRequestAction = "denied"
+)
+
+// Event returns the personal_access_token_request event restricted to the action, for filtering it in Parse
+func (a PersonalAccessTokenRequestAction) Event() Event {
+	return PersonalAccessTokenRequestEvent.WithAction(string(a))
+}
+
+// PingPayload contains the information for GitHub's ping hook event
+type PingPayload struct {
+	HookID int `json:"hook_id"`
+	Hook   struct {
+		Type   string   `json:"type"`
+		ID     int64    `json:"id"`
+		NodeID string   `json:"node_id"`
+		Name   string   `json:"name"`
+		Active bool     `json:"active"`
+		Events []string `json:"events"`
+		AppID  int      `json:"app_id"`
+		Config struct {
+			ContentType string `json:"content_type"`
+			InsecureSSL string `json:"insecure_ssl"`
+			Secret      string `json:"secret"`
+			URL         string `json:"url"`
+		} `json:"config"`
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+	} `json:"hook"`
+	Repository   Repository    `json:"repository,omitempty"`
+	Sender       User          `json:"sender,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+}
+
+// ProjectPayload contains the information for GitHub's project hook event
+type ProjectPayload struct {
+	Action  ProjectAction `json:"action"`
+	Project struct {
+		OwnerURL   string    `json:"owner_url"`
+		URL        string    `json:"url"`
+		ColumnsURL string    `json:"columns_url"`
+		ID         int64     `json:"id"`
+		NodeID     string    `json:"node_id"`
+		Name       string    `json:"name"`
+		Body       string    `json:"body"`
+		Number     int64     `json:"number"`
+		State      string    `json:"state"`
+		Creator    User      `json:"creator"`
+		CreatedAt  Timestamp `json:"created_at"`
+		UpdatedAt  Timestamp `json:"updated_at"`
+	} `json:"project"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// ProjectAction is the action of the project event
+type ProjectAction string
+
+// project actions
+const (
+	ProjectActionClosed   ProjectAction = "closed"
+	ProjectActionCreated  ProjectAction = "created"
+	ProjectActionDeleted  ProjectAction = "deleted"
+	ProjectActionEdited   ProjectAction = "edited"
+	ProjectActionReopened ProjectAction = "reopened"
+)
+
+// Event returns the project event restricted to the action, for filtering it in Parse
+func (a ProjectAction) Event() Event {
+	return ProjectEvent.WithAction(string(a))
+}
+
+// ProjectCardPayload contains the information for GitHub's project_card hook event
+type ProjectCardPayload struct {
+	Action      ProjectCardAction `json:"action"`
+	ProjectCard struct {
+		URL        string    `json:"url"`
+		ProjectURL string    `json:"project_url"`
+		ColumnURL  string    `json:"column_url"`
+		ColumnID   int64     `json:"column_id"`
+		ID         int64     `json:"id"`
+		NodeID     string    `json:"node_id"`
+		Note       *string   `json:"note"`
+		Creator    User      `json:"creator"`
+		CreatedAt  Timestamp `json:"created_at"`
+		UpdatedAt  Timestamp `json:"updated_at"`
+		ContentURL string    `json:"content_url"`
+	} `json:"project_card"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// ProjectCardAction is the action of the project_card event
+type ProjectCardAction string
+
+// project_card actions
+const (
+	ProjectCardActionConverted ProjectCardAction = "converted"
+	ProjectCardActionCreated   ProjectCardAction = "created"
+	ProjectCardActionDeleted   ProjectCardAction = "deleted"
+	ProjectCardActionEdited    ProjectCardAction = "edited"
+	ProjectCardActionMoved     ProjectCardAction = "moved"
+)
+
+// Event returns the project_card event restricted to the action, for filtering it in Parse
+func (a ProjectCardAction) Event() Event {
+	return ProjectCardEvent.WithAction(string(a))
+}
+
+// ProjectColumnPayload contains the information for GitHub's project_column hook event
+type ProjectColumnPayload struct {
+	Action        ProjectColumnAction `json:"action"`
+	ProjectColumn struct {
+		URL        string    `json:"url"`
+		ProjectURL string    `json:"project_url"`
+		CardsURL   string    `json:"cards_url"`
+		ID         int64     `json:"id"`
+		NodeID     string    `json:"node_id"`
+		Name       string    `json:"name"`
+		CreatedAt  Timestamp `json:"created_at"`
+		UpdatedAt  Timestamp `json:"updated_at"`
+	} `json:"project_column"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// ProjectColumnAction is the action of the project_column event
+type ProjectColumnAction string
+
+// project_column actions
+const (
+	ProjectColumnActionCreated ProjectColumnAction = "created"
+	ProjectColumnActionDeleted ProjectColumnAction = "deleted"
+	ProjectColumnActionEdited  ProjectColumnAction = "edited"
+	ProjectColumnActionMoved   ProjectColumnAction = "moved"
+)
+
+// Event returns the project_column event restricted to the action, for filtering it in Parse
+func (a ProjectColumnAction) Event() Event {
+	return ProjectColumnEvent.WithAction(string(a))
+}
+
+// ProjectsV2Payload contains the information for GitHub's projects_v2 hook event
+type ProjectsV2Payload struct {
+	Action     ProjectsV2Action `json:"action"`
+	ProjectsV2 struct {
+		ID               int64      `json:"id"`
+		NodeID           string     `json:"node_id"`
+		Owner            User       `json:"owner"`
+		Creator          User       `json:"creator"`
+		Title            string     `json:"title"`
+		Description      *string    `json:"description"`
+		Public           bool       `json:"public"`
+		ClosedAt         *Timestamp `json:"closed_at"`
+		CreatedAt        Timestamp  `json:"created_at"`
+		UpdatedAt        Timestamp  `json:"updated_at"`
+		Number           int64      `json:"number"`
+		ShortDescription *string    `json:"short_description"`
+		DeletedAt        *Timestamp `json:"deleted_at"`
+		DeletedBy        *User      `json:"deleted_by"`
+	} `json:"projects_v2"`
+	Changes *struct {
+		Title *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"title,omitempty"`
+		Description *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"description,omitempty"`
+		ShortDescription *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"short_description,omitempty"`
+		Public *struct {
+			From bool `json:"from"`
+			To   bool `json:"to"`
+		} `json:"public,omitempty"`
+	} `json:"changes,omitempty"`
+	Organization Organization `json:"organization"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// ProjectsV2Action is the action of the projects_v2 event
+type ProjectsV2Action string
+
+// projects_v2 actions
+const (
+	ProjectsV2ActionClosed   ProjectsV2Action = "closed"
+	ProjectsV2ActionCreated  ProjectsV2Action = "created"
+	ProjectsV2ActionDeleted  ProjectsV2Action = "deleted"
+	ProjectsV2ActionEdited   ProjectsV2Action = "edited"
+	ProjectsV2ActionReopened ProjectsV2Action = "reopened"
+)
+
+// Event returns the projects_v2 event restricted to the action, for filtering it in Parse
+func (a ProjectsV2Action) Event() Event {
+	return ProjectsV2Event.WithAction(string(a))
+}
+
+// ProjectsV2ItemPayload contains the information for GitHub's projects_v2_item hook event
+type ProjectsV2ItemPayload struct {
+	Action         ProjectsV2ItemAction `json:"action"`
+	ProjectsV2Item struct {
+		ID            int64      `json:"id"`
+		NodeID        string     `json:"node_id"`
+		ProjectNodeID string     `json:"project_node_id"`
+		ContentNodeID string     `json:"content_node_id"`
+		ContentType   string     `json:"content_type"`
+		Creator       *User      `json:"creator"`
+		CreatedAt     Timestamp  `json:"created_at"`
+		UpdatedAt     Timestamp  `json:"updated_at"`
+		ArchivedAt    *Timestamp `json:"archived_at"`
+	} `json:"projects_v2_item"`
+	Changes *struct {
+		FieldValue *ProjectsV2FieldValueChange `json:"field_value,omitempty"`
+		Body       *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"body,omitempty"`
+		ArchivedAt *struct {
+			From *Timestamp `json:"from"`
+			To   *Timestamp `json:"to"`
+		} `json:"archived_at,omitempty"`
+		ContentType *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"content_type,omitempty"`
+		PreviousProjectsV2ItemNodeID *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"previous_projects_v2_item_node_id,omitempty"`
+	} `json:"changes,omitempty"`
+	Organization Organization `json:"organization"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// ProjectsV2ItemAction is the action of the projects_v2_item event
+type ProjectsV2ItemAction string
+
+// projects_v2_item actions
+const (
+	ProjectsV2ItemActionArchived  ProjectsV2ItemAction = "archived"
+	ProjectsV2ItemActionConverted ProjectsV2ItemAction = "converted"
+	ProjectsV2ItemActionCreated   ProjectsV2ItemAction = "created"
+	ProjectsV2ItemActionDeleted   ProjectsV2ItemAction = "deleted"
+	ProjectsV2ItemActionEdited    ProjectsV2ItemAction = "edited"
+	ProjectsV2ItemActionReordered ProjectsV2ItemAction = "reordered"
+	ProjectsV2ItemActionRestored  ProjectsV2ItemAction = "restored"
+)
+
+// Event returns the projects_v2_item event restricted to the action, for filtering it in Parse
+func (a ProjectsV2ItemAction) Event() Event {
+	return ProjectsV2ItemEvent.WithAction(string(a))
+}
+
+// ProjectsV2StatusUpdatePayload contains the information for GitHub's projects_v2_status_update hook event
+type ProjectsV2StatusUpdatePayload struct {
+	Action                 ProjectsV2StatusUpdateAction `json:"action"`
+	ProjectsV2StatusUpdate struct {
+		ID            int64      `json:"id"`
+		NodeID        string     `json:"node_id"`
+		ProjectNodeID string     `json:"project_node_id"`
+		Creator       *User      `json:"creator"`
+		CreatedAt     Timestamp  `json:"created_at"`
+		UpdatedAt     Timestamp  `json:"updated_at"`
+		Status        *string    `json:"status"`
+		StartDate     *Timestamp `json:"start_date"`
+		TargetDate    *Timestamp `json:"target_date"`
+		Body          *string    `json:"body"`
+	} `json:"projects_v2_status_update"`
+	Changes *struct {
+		Body *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"body,omitempty"`
+		Status *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"status,omitempty"`
+		StartDate *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"start_date,omitempty"`
+		TargetDate *struct {
+			From *string `json:"from"`
+			To   *string `json:"to"`
+		} `json:"target_date,omitempty"`
+	} `json:"changes,omitempty"`
+	Organization Organization `json:"organization"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// ProjectsV2StatusUpdateAction is the action of the projects_v2_status_update event
+type ProjectsV2StatusUpdateAction string
+
+// projects_v2_status_update actions
+const (
+	ProjectsV2StatusUpdateActionCreated ProjectsV2StatusUpdateAction = "created"
+	ProjectsV2StatusUpdateActionDeleted ProjectsV2StatusUpdateAction = "deleted"
+	ProjectsV2StatusUpdateActionEdited  ProjectsV2StatusUpdateAction = "edited"
+)
+
+// Event returns the projects_v2_status_update event restricted to the action, for filtering it in Parse
+func (a ProjectsV2StatusUpdateAction) Event() Event {
+	return ProjectsV2StatusUpdateEvent.WithAction(string(a))
+}
+
+// PublicPayload contains the information for GitHub's public hook event
+type PublicPayload struct {
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PullRequestPayload contains the information for GitHub's pull_request hook event
+type PullRequestPayload struct {
+	Action      PullRequestAction `json:"action"`
+	Number      int64             `json:"number"`
+	PullRequest PullRequest       `json:"pull_request"`
+	Label       Label             `json:"label,omitempty"`
+	Repository  Repository        `json:"repository"`
+	Sender      User              `json:"sender"`
+	Changes     *struct {
+		Title *struct {
+			From string `json:"from"`
+		} `json:"title"`
+		Body *struct {
+			From string `json:"from"`
+		} `json:"body"`
+	} `json:"changes,omitempty"`
+	Assignee          *User `json:"assignee,omitempty"`
+	RequestedReviewer *User `json:"requested_reviewer,omitempty"`
+	RequestedTeam     struct {
+		Name            string `json:"name"`
+		ID              int64  `json:"id"`
+		NodeID          string `json:"node_id"`
+		Slug            string `json:"slug"`
+		Description     string `json:"description"`
+		Privacy         string `json:"privacy"`
+		URL             string `json:"url"`
+		HTMLURL         string `json:"html_url"`
+		MembersURL      string `json:"members_url"`
+		RepositoriesURL string `json:"repositories_url"`
+		Permission      string `json:"permission"`
+	} `json:"requested_team,omitempty"`
+	Installation Installation  `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PullRequestAction is the action of the pull_request event
+type PullRequestAction string
+
+// pull_request actions
+const (
+	PullRequestActionAssigned             PullRequestAction = "assigned"
+	PullRequestActionAutoMergeDisabled    PullRequestAction = "auto_merge_disabled"
+	PullRequestActionAutoMergeEnabled     PullRequestAction = "auto_merge_enabled"
+	PullRequestActionClosed               PullRequestAction = "closed"
+	PullRequestActionConvertedToDraft     PullRequestAction = "converted_to_draft"
+	PullRequestActionDemilestoned         PullRequestAction = "demilestoned"
+	PullRequestActionDequeued             PullRequestAction = "dequeued"
+	PullRequestActionEdited               PullRequestAction = "edited"
+	PullRequestActionEnqueued             PullRequestAction = "enqueued"
+	PullRequestActionLabeled              PullRequestAction = "labeled"
+	PullRequestActionLocked               PullRequestAction = "locked"
+	PullRequestActionMilestoned           PullRequestAction = "milestoned"
+	PullRequestActionOpened               PullRequestAction = "opened"
+	PullRequestActionReadyForReview       PullRequestAction = "ready_for_review"
+	PullRequestActionReopened             PullRequestAction = "reopened"
+	PullRequestActionReviewRequestRemoved PullRequestAction = "review_request_removed"
+	PullRequestActionReviewRequested      PullRequestAction = "review_requested"
+	PullRequestActionSynchronize          PullRequestAction = "synchronize"
+	PullRequestActionUnassigned           PullRequestAction = "unassigned"
+	PullRequestActionUnlabeled            PullRequestAction = "unlabeled"
+	PullRequestActionUnlocked             PullRequestAction = "unlocked"
+)
+
+// Event returns the pull_request event restricted to the action, for filtering it in Parse
+func (a PullRequestAction) Event() Event {
+	return PullRequestEvent.WithAction(string(a))
+}
+
+// PullRequestReviewPayload contains the information for GitHub's pull_request_review hook event
+type PullRequestReviewPayload struct {
+	Action PullRequestReviewAction `json:"action"`
+	Review struct {
+		ID             int64     `json:"id"`
+		NodeID         string    `json:"node_id"`
+		User           User      `json:"user"`
+		Body           string    `json:"body"`
+		SubmittedAt    Timestamp `json:"submitted_at"`
+		State          string    `json:"state"`
+		HTMLURL        string    `json:"html_url"`
+		PullRequestURL string    `json:"pull_request_url"`
+		Links          struct {
+			HTML        Link `json:"html"`
+			PullRequest Link `json:"pull_request"`
+		} `json:"_links"`
+	} `json:"review"`
+	PullRequest  PullRequest   `json:"pull_request"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation Installation  `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PullRequestReviewAction is the action of the pull_request_review event
+type PullRequestReviewAction string
+
+// pull_request_review actions
+const (
+	PullRequestReviewActionDismissed PullRequestReviewAction = "dismissed"
+	PullRequestReviewActionEdited    PullRequestReviewAction = "edited"
+	PullRequestReviewActionSubmitted PullRequestReviewAction = "submitted"
+)
+
+// Event returns the pull_request_review event restricted to the action, for filtering it in Parse
+func (a PullRequestReviewAction) Event() Event {
+	return PullRequestReviewEvent.WithAction(string(a))
+}
+
+// PullRequestReviewCommentPayload contains the information for GitHub's pull_request_review_comment hook event
+type PullRequestReviewCommentPayload struct {
+	Action  PullRequestReviewCommentAction `json:"action"`
+	Comment struct {
+		URL               string    `json:"url"`
+		ID                int64     `json:"id"`
+		NodeID            string    `json:"node_id"`
+		DiffHunk          string    `json:"diff_hunk"`
+		Path              string    `json:"path"`
+		Position          int64     `json:"position"`
+		OriginalPosition  int64     `json:"original_position"`
+		CommitID          string    `json:"commit_id"`
+		OriginalCommitID  string    `json:"original_commit_id"`
+		User              User      `json:"user"`
+		Body              string    `json:"body"`
+		Reactions         Reactions `json:"reactions,omitempty"`
+		AuthorAssociation string    `json:"author_association"`
+		CreatedAt         Timestamp `json:"created_at"`
+		UpdatedAt         Timestamp `json:"updated_at"`
+		HTMLURL           string    `json:"html_url"`
+		PullRequestURL    string    `json:"pull_request_url"`
+		Links             struct {
+			Self        Link `json:"self"`
+			HTML        Link `json:"html"`
+			PullRequest Link `json:"pull_request"`
+		} `json:"_links"`
+		InReplyToID int64 `json:"in_reply_to_id"`
+	} `json:"comment"`
+	PullRequest  PullRequest   `json:"pull_request"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation Installation  `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// PullRequestReviewCommentAction is the action of the pull_request_review_comment event
+type PullRequestReviewCommentAction string
+
+// pull_request_review_comment actions
+const (
+	PullRequestReviewCommentActionCreated PullRequestReviewCommentAction = "created"
+	PullRequestReviewCommentActionDeleted PullRequestReviewCommentAction = "deleted"
+	PullRequestReviewCommentActionEdited  PullRequestReviewCommentAction = "edited"
+)
+
+// Event returns the pull_request_review_comment event restricted to the action, for filtering it in Parse
+func (a PullRequestReviewCommentAction) Event() Event {
+	return PullRequestReviewCommentEvent.WithAction(string(a))
+}
+
+// PullRequestReviewThreadPayload contains the information for GitHub's pull_request_review_thread hook event
+type PullRequestReviewThreadPayload struct {
+	Action PullRequestReviewThreadAction `json:"action"`
+	Thread struct {
+		NodeID   string `json:"node_id"`
+		Comments []struct {
+			URL                 string    `json:"url"`
+			PullRequestReviewID int64     `json:"pull_request_review_id"`
+			ID                  int64     `json:"id"`
+			NodeID              string    `json:"node_id"`
+			DiffHunk            string    `json:"diff_hunk"`
+			Path                string    `json:"path"`
+			Position            *int64    `json:"position"`
+			OriginalPosition    int64     `json:"original_position"`
+			CommitID            string    `json:"commit_id"`
+			OriginalCommitID    string    `json:"original_commit_id"`
+			User                User      `json:"user"`
+			Body                string    `json:"body"`
+			AuthorAssociation   string    `json:"author_association"`
+			CreatedAt           Timestamp `json:"created_at"`
+			UpdatedAt           Timestamp `json:"updated_at"`
+			HTMLURL             string    `json:"html_url"`
+			PullRequestURL      string    `json:"pull_request_url"`
+			Links               struct {
+				Self        Link `json:"self"`
+				HTML        Link `json:"html"`
+				PullRequest Link `json:"pull_request"`
+			} `json:"_links"`
+			StartLine         *int64  `json:"start_line"`
+			OriginalStartLine *int64  `json:"original_start_line"`
+			StartSide         *string `json:"start_side"`
+			Line              *int64  `json:"line"`
+			OriginalLine      int64   `json:"original_line"`
+			Side              string  `json:"side"`
+			SubjectType       string  `json:"subject_type,omitempty"`
+			InReplyToID       int64   `json:"in_reply_to_id,omitempty"`
+		} `json:"comments"`
+	} `json:"thread"`
+	PullRequest  PullRequest  `json:"pull_request"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// PullRequestReviewThreadAction is the action of the pull_request_review_thread event
+type PullRequestReviewThreadAction string
+
+// pull_request_review_thread actions
+const (
+	PullRequestReviewThreadActionResolved   PullRequestReviewThreadAction = "resolved"
+	PullRequestReviewThreadActionUnresolved PullRequestReviewThreadAction = "unresolved"
+)
+
+// Event returns the pull_request_review_thread event restricted to the action, for filtering it in Parse
+func (a PullRequestReviewThreadAction) Event() Event {
+	return PullRequestReviewThreadEvent.WithAction(string(a))
+}
+
+// PushPayload contains the information for GitHub's push hook event
+type PushPayload struct {
+	Ref        string     `json:"ref"`
+	Before     string     `json:"before"`
+	After      string     `json:"after"`
+	Created    bool       `json:"created"`
+	Deleted    bool       `json:"deleted"`
+	Forced     bool       `json:"forced"`
+	BaseRef    *string    `json:"base_ref"`
+	Compare    string     `json:"compare"`
+	Commits    []Commit   `json:"commits"`
+	HeadCommit Commit     `json:"head_commit"`
+	Repository Repository `json:"repository"`
+	Pusher     struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"pusher"`
+	Sender       User          `json:"sender"`
+	Installation Installation  `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// RegistryPackagePayload contains the information for GitHub's registry_package hook event
+type RegistryPackagePayload struct {
+	Action          RegistryPackageAction `json:"action"`
+	RegistryPackage Package               `json:"registry_package"`
+	Repository      Repository            `json:"repository"`
+	Organization    Organization          `json:"organization,omitempty"`
+	Sender          User                  `json:"sender"`
+	Installation    Installation          `json:"installation,omitempty"`
+	Enterprise      *Enterprise           `json:"enterprise,omitempty"`
+}
+
+// RegistryPackageAction is the action of the registry_package event
+type RegistryPackageAction string
+
+// registry_package actions
+const (
+	RegistryPackageActionPublished RegistryPackageAction = "published"
+	RegistryPackageActionUpdated   RegistryPackageAction = "updated"
+)
+
+// Event returns the registry_package event restricted to the action, for filtering it in Parse
+func (a RegistryPackageAction) Event() Event {
+	return RegistryPackageEvent.WithAction(string(a))
+}
+
+// ReleasePayload contains the information for GitHub's release hook event
+type ReleasePayload struct {
+	Action  ReleaseAction `json:"action"`
+	Release struct {
+		URL             string    `json:"url"`
+		AssetsURL       string    `json:"assets_url"`
+		UploadURL       string    `json:"upload_url"`
+		HTMLURL         string    `json:"html_url"`
+		ID              int64     `json:"id"`
+		NodeID          string    `json:"node_id"`
+		TagName         string    `json:"tag_name"`
+		TargetCommitish string    `json:"target_commitish"`
+		Name            *string   `json:"name"`
+		Draft           bool      `json:"draft"`
+		Author          User      `json:"author"`
+		Prerelease      bool      `json:"prerelease"`
+		CreatedAt       Timestamp `json:"created_at"`
+		PublishedAt     Timestamp `json:"published_at"`
+		Assets          []Asset   `json:"assets"`
+		TarballURL      string    `json:"tarball_url"`
+		ZipballURL      string    `json:"zipball_url"`
+		Body            *string   `json:"body"`
+	} `json:"release"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation Installation  `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// ReleaseAction is the action of the release event
+type ReleaseAction string
+
+// release actions
+const (
+	ReleaseActionCreated     ReleaseAction = "created"
+	ReleaseActionDeleted     ReleaseAction = "deleted"
+	ReleaseActionEdited      ReleaseAction = "edited"
+	ReleaseActionPrereleased ReleaseAction = "prereleased"
+	ReleaseActionPublished   ReleaseAction = "published"
+	ReleaseActionReleased    ReleaseAction = "released"
+	ReleaseActionUnpublished ReleaseAction = "unpublished"
+)
+
+// Event returns the release event restricted to the action, for filtering it in Parse
+func (a ReleaseAction) Event() Event {
+	return ReleaseEvent.WithAction(string(a))
+}
+
+// RepositoryPayload contains the information for GitHub's repository hook event
+type RepositoryPayload struct {
+	Action  RepositoryAction `json:"action"`
+	Changes struct {
+		DefaultBranch struct {
+			From string `json:"from"`
+		} `json:"default_branch,omitempty"`
+		Description struct {
+			From string `json:"from"`
+		} `json:"description,omitempty"`
+		Homepage struct {
+			From string `json:"from"`
+		} `json:"homepage,omitempty"`
+		Topics struct {
+			From string `json:"from,omitempty"`
+		} `json:"topics,omitempty"`
+		Repository struct {
+			Name struct {
+				From string `json:"from"`
+			} `json:"name"`
+		} `json:"repository,omitempty"`
+		Owner struct {
+			From string `json:"from"`
+		} `json:"owner,omitempty"`
+	} `json:"changes,omitempty"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Sender       User          `json:"sender"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// RepositoryAction is the action of the repository event
+type RepositoryAction string
+
+// repository actions
+const (
+	RepositoryActionAnonymousAccessDisabled RepositoryAction = "anonymous_access_disabled"
+	RepositoryActionAnonymousAccessEnabled  RepositoryAction = "anonymous_access_enabled"
+	RepositoryActionArchived                RepositoryAction = "archived"
+	RepositoryActionCreated                 RepositoryAction = "created"
+	RepositoryActionDeleted                 RepositoryAction = "deleted"
+	RepositoryActionEdited                  RepositoryAction = "edited"
+	RepositoryActionPrivatized              RepositoryAction = "privatized"
+	RepositoryActionPublicized              RepositoryAction = "publicized"
+	RepositoryActionRenamed                 RepositoryAction = "renamed"
+	RepositoryActionTransferred             RepositoryAction = "transferred"
+	RepositoryActionUnarchived              RepositoryAction = "unarchived"
+)
+
+// Event returns the repository event restricted to the action, for filtering it in Parse
+func (a RepositoryAction) Event() Event {
+	return RepositoryEvent.WithAction(string(a))
+}
+
+// RepositoryAdvisoryPayload contains the information for GitHub's repository_advisory hook event
+type RepositoryAdvisoryPayload struct {
+	Action             RepositoryAdvisoryAction `json:"action"`
+	RepositoryAdvisory struct {
+		GhsaID      string  `json:"ghsa_id"`
+		CveID       *string `json:"cve_id"`
+		URL         string  `json:"url"`
+		HTMLURL     string  `json:"html_url"`
+		Summary     string  `json:"summary"`
+		Description *string `json:"description"`
+		Severity    *string `json:"severity"`
+		Author      *User   `json:"author"`
+		Publisher   *User   `json:"publisher"`
+		Identifiers []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"identifiers"`
+		State       string     `json:"state"`
+		CreatedAt   Timestamp  `json:"created_at"`
+		UpdatedAt   Timestamp  `json:"updated_at"`
+		PublishedAt *Timestamp `json:"published_at"`
+		ClosedAt    *Timestamp `json:"closed_at"`
+		WithdrawnAt *Timestamp `json:"withdrawn_at"`
+		Submission  *struct {
+			Accepted bool `json:"accepted"`
+		} `json:"submission"`
+		Vulnerabilities []struct {
+			Package struct {
+				Ecosystem string  `json:"ecosystem"`
+				Name      *string `json:"name"`
+			} `json:"package"`
+			VulnerableVersionRange *string  `json:"vulnerable_version_range"`
+			PatchedVersions        *string  `json:"patched_versions"`
+			VulnerableFunctions    []string `json:"vulnerable_functions"`
+		} `json:"vulnerabilities"`
+		Cvss *struct {
+			VectorString *string  `json:"vector_string"`
+			Score        *float64 `json:"score"`
+		} `json:"cvss"`
+		CvssSeverities *struct {
+			CvssV3 *struct {
+				VectorString *string  `json:"vector_string"`
+				Score        *float64 `json:"score"`
+			} `json:"cvss_v3"`
+			CvssV4 *struct {
+				VectorString *string  `json:"vector_string"`
+				Score        *float64 `json:"score"`
+			} `json:"cvss_v4"`
+		} `json:"cvss_severities,omitempty"`
+		Cwes []struct {
+			CweID string `json:"cwe_id"`
+			Name  string `json:"name"`
+		} `json:"cwes"`
+		CweIDs  []string `json:"cwe_ids"`
+		Credits []struct {
+			Login string `json:"login"`
+			Type  string `json:"type"`
+		} `json:"credits"`
+		CreditsDetailed []struct {
+			User  User   `json:"user"`
+			Type  string `json:"type"`
+			State string `json:"state"`
+		} `json:"credits_detailed"`
+		CollaboratingUsers []User      `json:"collaborating_users"`
+		CollaboratingTeams []Team      `json:"collaborating_teams"`
+		PrivateFork        *Repository `json:"private_fork"`
+	} `json:"repository_advisory"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// RepositoryAdvisoryAction is the action of the repository_advisory event
+type RepositoryAdvisoryAction string
+
+// repository_advisory actions
+const (
+	RepositoryAdvisoryActionPublished RepositoryAdvisoryAction = "published"
+	RepositoryAdvisoryActionReported  RepositoryAdvisoryAction = "reported"
+)
+
+// Event returns the repository_advisory event restricted to the action, for filtering it in Parse
+func (a RepositoryAdvisoryAction) Event() Event {
+	return RepositoryAdvisoryEvent.WithAction(string(a))
+}
+
+// RepositoryDispatchPayload contains the information for GitHub's repository_dispatch hook event
+type RepositoryDispatchPayload struct {
+	Action        string          `json:"action"`
+	Branch        string          `json:"branch"`
+	ClientPayload json.RawMessage `json:"client_payload"`
+	Repository    Repository      `json:"repository"`
+	Organization  Organization    `json:"organization,omitempty"`
+	Sender        User            `json:"sender"`
+	Installation  Installation    `json:"installation,omitempty"`
+	Enterprise    *Enterprise     `json:"enterprise,omitempty"`
+}
+
+// RepositoryImportPayload contains the information for GitHub's repository_import hook event
+type RepositoryImportPayload struct {
+	Status       string       `json:"status"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   Enterprise   `json:"enterprise,omitempty"`
+}
+
+// RepositoryRulesetPayload contains the information for GitHub's repository_ruleset hook event
+type RepositoryRulesetPayload struct {
+	Action            RepositoryRulesetAction `json:"action"`
+	RepositoryRuleset RepositoryRuleset       `json:"repository_ruleset"`
+	Changes           *struct {
+		Name *struct {
+			From string `json:"from"`
+		} `json:"name,omitempty"`
+		Enforcement *struct {
+			From string `json:"from"`
+		} `json:"enforcement,omitempty"`
+		Conditions *struct {
+			Added   []RulesetConditions `json:"added"`
+			Deleted []RulesetConditions `json:"deleted"`
+			Updated []struct {
+				Condition RulesetConditions `json:"condition"`
+				Changes   struct {
+					ConditionType *struct {
+						From string `json:"from"`
+					} `json:"condition_type,omitempty"`
+					Target *struct {
+						From string `json:"from"`
+					} `json:"target,omitempty"`
+					Include *struct {
+						From []string `json:"from"`
+					} `json:"include,omitempty"`
+					Exclude *struct {
+						From []string `json:"from"`
+					} `json:"exclude,omitempty"`
+				} `json:"changes"`
+			} `json:"updated"`
+		} `json:"conditions,omitempty"`
+		Rules *struct {
+			Added   []RulesetRule `json:"added"`
+			Deleted []RulesetRule `json:"deleted"`
+			Updated []struct {
+				Rule    RulesetRule `json:"rule"`
+				Changes struct {
+					Configuration *struct {
+						From string `json:"from"`
+					} `json:"configuration,omitempty"`
+					RuleType *struct {
+						From string `json:"from"`
+					} `json:"rule_type,omitempty"`
+					Pattern *struct {
+						From string `json:"from"`
+					} `json:"pattern,omitempty"`
+				} `json:"changes"`
+			} `json:"updated"`
+		} `json:"rules,omitempty"`
+	} `json:"changes,omitempty"`
+	Repository   *Repository  `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// RepositoryRulesetAction is the action of the repository_ruleset event
+type RepositoryRulesetAction string
+
+// repository_ruleset actions
+const (
+	RepositoryRulesetActionCreated RepositoryRulesetAction = "created"
+	RepositoryRulesetActionDeleted RepositoryRulesetAction = "deleted"
+	RepositoryRulesetActionEdited  RepositoryRulesetAction = "edited"
+)
+
+// Event returns the repository_ruleset event restricted to the action, for filtering it in Parse
+func (a RepositoryRulesetAction) Event() Event {
+	return RepositoryRulesetEvent.WithAction(string(a))
+}
+
+// RepositoryVulnerabilityAlertPayload contains the information for GitHub's repository_vulnerability_alert hook event
+type RepositoryVulnerabilityAlertPayload struct {
+	Action RepositoryVulnerabilityAlertAction `json:"action"`
+	Alert  struct {
+		ID                  int64  `json:"id"`
+		Summary             string `json:"summary"`
+		AffectedRange       string `json:"affected_range"`
+		AffectedPackageName string `json:"affected_package_name"`
+		ExternalReference   string `json:"external_reference"`
+		ExternalIdentifier  string `json:"external_identifier"`
+		FixedIn             string `json:"fixed_in"`
+		Dismisser           User   `json:"dismisser"`
+	} `json:"alert"`
+	Installation *Installation `json:"installation,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// RepositoryVulnerabilityAlertAction is the action of the repository_vulnerability_alert event
+type RepositoryVulnerabilityAlertAction string
+
+// repository_vulnerability_alert actions
+const (
+	RepositoryVulnerabilityAlertActionCreate  RepositoryVulnerabilityAlertAction = "create"
+	RepositoryVulnerabilityAlertActionDismiss RepositoryVulnerabilityAlertAction = "dismiss"
+	RepositoryVulnerabilityAlertActionReopen  RepositoryVulnerabilityAlertAction = "reopen"
+	RepositoryVulnerabilityAlertActionResolve RepositoryVulnerabilityAlertAction = "resolve"
+)
+
+// Event returns the repository_vulnerability_alert event restricted to the action, for filtering it in Parse
+func (a RepositoryVulnerabilityAlertAction) Event() Event {
+	return RepositoryVulnerabilityAlertEvent.WithAction(string(a))
+}
+
+// SecretScanningAlertPayload contains the information for GitHub's secret_scanning_alert hook event
+type SecretScanningAlertPayload struct {
+	Action       SecretScanningAlertAction `json:"action"`
+	Alert        SecretScanningAlert       `json:"alert"`
+	Assignee     *User                     `json:"assignee,omitempty"`
+	Repository   Repository                `json:"repository"`
+	Organization Organization              `json:"organization,omitempty"`
+	Enterprise   *Enterprise               `json:"enterprise,omitempty"`
+	Sender       User                      `json:"sender"`
+	Installation Installation              `json:"installation,omitempty"`
+}
+
+// SecretScanningAlertAction is the action of the secret_scanning_alert event
+type SecretScanningAlertAction string
+
+// secret_scanning_alert actions
+const (
+	SecretScanningAlertActionAssigned       SecretScanningAlertAction = "assigned"
+	SecretScanningAlertActionCreated        SecretScanningAlertAction = "created"
+	SecretScanningAlertActionPubliclyLeaked SecretScanningAlertAction = "publicly_leaked"
+	SecretScanningAlertActionReopened       SecretScanningAlertAction = "reopened"
+	SecretScanningAlertActionResolved       SecretScanningAlertAction = "resolved"
+	SecretScanningAlertActionUnassigned     SecretScanningAlertAction = "unassigned"
+	SecretScanningAlertActionValidated      SecretScanningAlertAction = "validated"
+)
+
+// Event returns the secret_scanning_alert event restricted to the action, for filtering it in Parse
+func (a SecretScanningAlertAction) Event() Event {
+	return SecretScanningAlertEvent.WithAction(string(a))
+}
+
+// SecretScanningAlertLocationPayload contains the information for GitHub's secret_scanning_alert_location hook event
+type SecretScanningAlertLocationPayload struct {
+	Action   SecretScanningAlertLocationAction `json:"action"`
+	Alert    SecretScanningAlert               `json:"alert"`
+	Location struct {
+		Type    string `json:"type"`
+		Details struct {
+			Path                        string `json:"path,omitempty"`
+			StartLine                   int64  `json:"start_line,omitempty"`
+			EndLine                     int64  `json:"end_line,omitempty"`
+			StartColumn                 int64  `json:"start_column,omitempty"`
+			EndColumn                   int64  `json:"end_column,omitempty"`
+			BlobSha                     string `json:"blob_sha,omitempty"`
+			BlobURL                     string `json:"blob_url,omitempty"`
+			CommitSha                   string `json:"commit_sha,omitempty"`
+			CommitURL                   string `json:"commit_url,omitempty"`
+			PageURL                     string `json:"page_url,omitempty"`
+			IssueTitleURL               string `json:"issue_title_url,omitempty"`
+			IssueBodyURL                string `json:"issue_body_url,omitempty"`
+			IssueCommentURL             string `json:"issue_comment_url,omitempty"`
+			DiscussionTitleURL          string `json:"discussion_title_url,omitempty"`
+			DiscussionBodyURL           string `json:"discussion_body_url,omitempty"`
+			DiscussionCommentURL        string `json:"discussion_comment_url,omitempty"`
+			PullRequestTitleURL         string `json:"pull_request_title_url,omitempty"`
+			PullRequestBodyURL          string `json:"pull_request_body_url,omitempty"`
+			PullRequestCommentURL       string `json:"pull_request_comment_url,omitempty"`
+			PullRequestReviewURL        string `json:"pull_request_review_url,omitempty"`
+			PullRequestReviewCommentURL string `json:"pull_request_review_comment_url,omitempty"`
+		} `json:"details"`
+	} `json:"location"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// SecretScanningAlertLocationAction is the action of the secret_scanning_alert_location event
+type SecretScanningAlertLocationAction string
+
+// secret_scanning_alert_location actions
+const (
+	SecretScanningAlertLocationActionCreated SecretScanningAlertLocationAction = "created"
+)
+
+// Event returns the secret_scanning_alert_location event restricted to the action, for filtering it in Parse
+func (a SecretScanningAlertLocationAction) Event() Event {
+	return SecretScanningAlertLocationEvent.WithAction(string(a))
+}
+
+// SecretScanningScanPayload contains the information for GitHub's secret_scanning_scan hook event
+type SecretScanningScanPayload struct {
+	Action             SecretScanningScanAction `json:"action"`
+	Type               string                   `json:"type"`
+	Source             string                   `json:"source"`
+	StartedAt          Timestamp                `json:"started_at"`
+	CompletedAt        Timestamp                `json:"completed_at"`
+	SecretTypes        []string                 `json:"secret_types"`
+	CustomPatternName  *string                  `json:"custom_pattern_name"`
+	CustomPatternScope *string                  `json:"custom_pattern_scope"`
+	Repository         Repository               `json:"repository"`
+	Organization       Organization             `json:"organization,omitempty"`
+	Enterprise         *Enterprise              `json:"enterprise,omitempty"`
+	Sender             User                     `json:"sender"`
+	Installation       Installation             `json:"installation,omitempty"`
+}
+
+// SecretScanningScanAction is the action of the secret_scanning_scan event
+type SecretScanningScanAction string
+
+// secret_scanning_scan actions
+const (
+	SecretScanningScanActionCompleted SecretScanningScanAction = "completed"
+)
+
+// Event returns the secret_scanning_scan event restricted to the action, for filtering it in Parse
+func (a SecretScanningScanAction) Event() Event {
+	return SecretScanningScanEvent.WithAction(string(a))
+}
+
+// SecurityAdvisoryPayload contains the information for GitHub's security_advisory hook event
+type SecurityAdvisoryPayload struct {
+	Action           SecurityAdvisoryAction `json:"action"`
+	SecurityAdvisory struct {
+		GHSAID      string `json:"ghsa_id"`
+		Summary     string `json:"summary"`
+		Description string `json:"description"`
+		Severity    string `json:"string"`
+		Identifiers []struct {
+			Value string `json:"value"`
+			Type  string `json:"type"`
+		} `json:"identifiers"`
+		References []struct {
+			URL string `json:"url"`
+		} `json:"references"`
+		PublishedAt     Timestamp  `json:"published_at"`
+		UpdatedAt       Timestamp  `json:"updated_at"`
+		WithdrawnAt     *Timestamp `json:"withdrawn_at"`
+		Vulnerabilities []struct {
+			Package struct {
+				Ecosystem string `json:"ecosystem"`
+				Name      string `json:"name"`
+			} `json:"package"`
+			Severity               string `json:"severity"`
+			VulnerableVersionRange string `json:"vulnerable_version_range"`
+			FirstPatchedVersion    *struct {
+				Identifier string `json:"identifier"`
+			} `json:"first_patched_version"`
+		} `json:"vulnerabilities"`
+	} `json:"security_advisory"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// SecurityAdvisoryAction is the action of the security_advisory event
+type SecurityAdvisoryAction string
+
+// security_advisory actions
+const (
+	SecurityAdvisoryActionPublished SecurityAdvisoryAction = "published"
+	SecurityAdvisoryActionUpdated   SecurityAdvisoryAction = "updated"
+	SecurityAdvisoryActionWithdrawn SecurityAdvisoryAction = "withdrawn"
+)
+
+// Event returns the security_advisory event restricted to the action, for filtering it in Parse
+func (a SecurityAdvisoryAction) Event() Event {
+	return SecurityAdvisoryEvent.WithAction(string(a))
+}
+
+// SecurityAndAnalysisPayload contains the information for GitHub's security_and_analysis hook event
+type SecurityAndAnalysisPayload struct {
+	Changes struct {
+		From struct {
+			SecurityAndAnalysis *SecurityAndAnalysis `json:"security_and_analysis"`
+		} `json:"from"`
+	} `json:"changes"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// SponsorshipPayload contains the information for GitHub's sponsorship hook event
+type SponsorshipPayload struct {
+	Action        SponsorshipAction `json:"action"`
+	EffectiveDate *Timestamp        `json:"effective_date,omitempty"`
+	Sponsorship   struct {
+		NodeID       string          `json:"node_id"`
+		CreatedAt    Timestamp       `json:"created_at"`
+		Sponsorable  User            `json:"sponsorable"`
+		Sponsor      User            `json:"sponsor"`
+		PrivacyLevel string          `json:"privacy_level"`
+		Tier         SponsorshipTier `json:"tier"`
+	} `json:"sponsorship"`
+	Changes *struct {
+		Tier *struct {
+			From SponsorshipTier `json:"from"`
+		} `json:"tier,omitempty"`
+		PrivacyLevel *struct {
+			From string `json:"from"`
+		} `json:"privacy_level,omitempty"`
+	} `json:"changes,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// SponsorshipAction is the action of the sponsorship event
+type SponsorshipAction string
+
+// sponsorship actions
+const (
+	SponsorshipActionCancelled           SponsorshipAction = "cancelled"
+	SponsorshipActionCreated             SponsorshipAction = "created"
+	SponsorshipActionEdited              SponsorshipAction = "edited"
+	SponsorshipActionPendingCancellation SponsorshipAction = "pending_cancellation"
+	SponsorshipActionPendingTierChange   SponsorshipAction = "pending_tier_change"
+	SponsorshipActionTierChanged         SponsorshipAction = "tier_changed"
+)
+
+// Event returns the sponsorship event restricted to the action, for filtering it in Parse
+func (a SponsorshipAction) Event() Event {
+	return SponsorshipEvent.WithAction(string(a))
+}
+
+// StarPayload contains the information for GitHub's star hook event
+type StarPayload struct {
+	Action       StarAction   `json:"action"`
+	StarredAt    *Timestamp   `json:"starred_at"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+}
+
+// StarAction is the action of the star event
+type StarAction string
+
+// star actions
+const (
+	StarActionCreated StarAction = "created"
+	StarActionDeleted StarAction = "deleted"
+)
+
+// Event returns the star event restricted to the action, for filtering it in Parse
+func (a StarAction) Event() Event {
+	return StarEvent.WithAction(string(a))
+}
+
+// StatusPayload contains the information for GitHub's status hook event
+type StatusPayload struct {
+	ID          int64   `json:"id"`
+	Sha         string  `json:"sha"`
+	Name        string  `json:"name"`
+	TargetURL   *string `json:"target_url"`
+	Context     string  `json:"context"`
+	Description *string `json:"description"`
+	State       string  `json:"state"`
+	Commit      struct {
+		Sha    string `json:"sha"`
+		NodeID string `json:"node_id"`
+		Commit struct {
+			Author struct {
+				Name  string    `json:"name"`
+				Email string    `json:"email"`
+				Date  Timestamp `json:"date"`
+			} `json:"author"`
+			Committer struct {
+				Name  string    `json:"name"`
+				Email string    `json:"email"`
+				Date  Timestamp `json:"date"`
+			} `json:"committer"`
+			Message string `json:"message"`
+			Tree    struct {
+				Sha string `json:"sha"`
+				URL string `json:"url"`
+			} `json:"tree"`
+			URL          string `json:"url"`
+			CommentCount int64  `json:"comment_count"`
+		} `json:"commit"`
+		URL         string   `json:"url"`
+		HTMLURL     string   `json:"html_url"`
+		CommentsURL string   `json:"comments_url"`
+		Author      User     `json:"author"`
+		Committer   User     `json:"committer"`
+		Parents     []Parent `json:"parents"`
+	} `json:"commit"`
+	Branches []struct {
+		Name   string `json:"name"`
+		Commit struct {
+			Sha string `json:"sha"`
+			URL string `json:"url"`
+		} `json:"commit"`
+	} `json:"branches"`
+	CreatedAt    Timestamp     `json:"created_at"`
+	UpdatedAt    Timestamp     `json:"updated_at"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// SubIssuesPayload contains the information for GitHub's sub_issues hook event
+type SubIssuesPayload struct {
+	Action          SubIssuesAction `json:"action"`
+	SubIssueID      int64           `json:"sub_issue_id"`
+	SubIssue        Issue           `json:"sub_issue"`
+	SubIssueRepo    *Repository     `json:"sub_issue_repo,omitempty"`
+	ParentIssueID   int64           `json:"parent_issue_id"`
+	ParentIssue     Issue           `json:"parent_issue"`
+	ParentIssueRepo *Repository     `json:"parent_issue_repo,omitempty"`
+	Repository      *Repository     `json:"repository"`
+	Organization    Organization    `json:"organization,omitempty"`
+	Sender          User            `json:"sender"`
+	Installation    Installation    `json:"installation,omitempty"`
+	Enterprise      *Enterprise     `json:"enterprise,omitempty"`
+}
+
+// SubIssuesAction is the action of the sub_issues event
+type SubIssuesAction string
+
+// sub_issues actions
+const (
+	SubIssuesActionParentIssueAdded   SubIssuesAction = "parent_issue_added"
+	SubIssuesActionParentIssueRemoved SubIssuesAction = "parent_issue_removed"
+	SubIssuesActionSubIssueAdded      SubIssuesAction = "sub_issue_added"
+	SubIssuesActionSubIssueRemoved    SubIssuesAction = "sub_issue_removed"
+)
+
+// Event returns the sub_issues event restricted to the action, for filtering it in Parse
+func (a SubIssuesAction) Event() Event {
+	return SubIssuesEvent.WithAction(string(a))
+}
+
+// TeamPayload contains the information for GitHub's team hook event
+type TeamPayload struct {
+	Action       TeamAction    `json:"action"`
+	Team         *Team         `json:"team"`
+	Organization Organization  `json:"organization"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Repository   *Repository   `json:"repository,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// TeamAction is the action of the team event
+type TeamAction string
+
+// team actions
+const (
+	TeamActionAddedToRepository     TeamAction = "added_to_repository"
+	TeamActionCreated               TeamAction = "created"
+	TeamActionDeleted               TeamAction = "deleted"
+	TeamActionEdited                TeamAction = "edited"
+	TeamActionRemovedFromRepository TeamAction = "removed_from_repository"
+)
+
+// Event returns the team event restricted to the action, for filtering it in Parse
+func (a TeamAction) Event() Event {
+	return TeamEvent.WithAction(string(a))
+}
+
+// TeamAddPayload contains the information for GitHub's team_add hook event
+type TeamAddPayload struct {
+	Team         *Team         `json:"team"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// UserPayload contains the information for GitHub's user hook event
+type UserPayload struct {
+	Action       UserAction   `json:"action"`
+	User         User         `json:"user"`
+	Enterprise   *Enterprise  `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// UserAction is the action of the user event
+type UserAction string
+
+// user actions
+const (
+	UserActionCreated UserAction = "created"
+	UserActionDeleted UserAction = "deleted"
+)
+
+// Event returns the user event restricted to the action, for filtering it in Parse
+func (a UserAction) Event() Event {
+	return UserEvent.WithAction(string(a))
+}
+
+// WatchPayload contains the information for GitHub's watch hook event
+type WatchPayload struct {
+	Action       WatchAction   `json:"action"`
+	Repository   Repository    `json:"repository"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Enterprise   *Enterprise   `json:"enterprise,omitempty"`
+}
+
+// WatchAction is the action of the watch event
+type WatchAction string
+
+// watch actions
+const (
+	WatchActionStarted WatchAction = "started"
+)
+
+// Event returns the watch event restricted to the action, for filtering it in Parse
+func (a WatchAction) Event() Event {
+	return WatchEvent.WithAction(string(a))
+}
+
+// WorkflowDispatchPayload contains the information for GitHub's workflow_dispatch hook event
+type WorkflowDispatchPayload struct {
+	Inputs       WorkflowDispatchInputs `json:"inputs"`
+	Ref          string                 `json:"ref"`
+	Repository   Repository             `json:"repository"`
+	Organization Organization           `json:"organization,omitempty"`
+	Sender       User                   `json:"sender"`
+	Workflow     string                 `json:"workflow"`
+	Installation *Installation          `json:"installation,omitempty"`
+	Enterprise   *Enterprise            `json:"enterprise,omitempty"`
+}
+
+// WorkflowJobPayload contains the information for GitHub's workflow_job hook event
+type WorkflowJobPayload struct {
+	Action      WorkflowJobAction `json:"action"`
+	WorkflowJob struct {
+		ID          int64     `json:"id"`
+		RunID       int64     `json:"run_id"`
+		RunURL      string    `json:"run_url"`
+		RunAttempt  int64     `json:"run_attempt"`
+		NodeID      string    `json:"node_id"`
+		HeadSha     string    `json:"head_sha"`
+		URL         string    `json:"url"`
+		HTMLURL     string    `json:"html_url"`
+		Status      string    `json:"status"`
+		Conclusion  string    `json:"conclusion"`
+		CreatedAt   Timestamp `json:"created_at"`
+		StartedAt   Timestamp `json:"started_at"`
+		CompletedAt Timestamp `json:"completed_at"`
+		Name        string    `json:"name"`
+		Steps       []struct {
+			Name        string    `json:"name"`
+			Status      string    `json:"status"`
+			Conclusion  string    `json:"conclusion"`
+			Number      int64     `json:"number"`
+			StartedAt   Timestamp `json:"started_at"`
+			CompletedAt Timestamp `json:"completed_at"`
+		} `json:"steps"`
+		CheckRunURL     string   `json:"check_run_url"`
+		Labels          []string `json:"labels"`
+		RunnerID        int64    `json:"runner_id"`
+		RunnerName      string   `json:"runner_name"`
+		RunnerGroupID   int64    `json:"runner_group_id"`
+		RunnerGroupName string   `json:"runner_group_name"`
+	} `json:"workflow_job"`
+	Repository   Repository   `json:"repository"`
+	Organization Organization `json:"organization,omitempty"`
+	Enterprise   Enterprise   `json:"enterprise,omitempty"`
+	Sender       User         `json:"sender"`
+	Installation Installation `json:"installation,omitempty"`
+}
+
+// WorkflowJobAction is the action of the workflow_job event
+type WorkflowJobAction string
+
+// workflow_job actions
+const (
+	WorkflowJobActionCompleted  WorkflowJobAction = "completed"
+	WorkflowJobActionInProgress WorkflowJobAction = "in_progress"
+	WorkflowJobActionQueued     WorkflowJobAction = "queued"
+	WorkflowJobActionWaiting    WorkflowJobAction = "waiting"
+)
+
+// Event returns the workflow_job event restricted to the action, for filtering it in Parse
+func (a WorkflowJobAction) Event() Event {
+	return WorkflowJobEvent.WithAction(string(a))
+}
+
+// WorkflowRunPayload contains the information for GitHub's workflow_run hook event
+type WorkflowRunPayload struct {
+	Action      WorkflowRunAction `json:"action"`
+	WorkflowRun struct {
+		ID               int64      `json:"id"`
+		Name             string     `json:"name"`
+		NodeID           string     `json:"node_id"`
+		HeadBranch       string     `json:"head_branch"`
+		HeadSha          string     `json:"head_sha"`
+		RunNumber        int64      `json:"run_number"`
+		Event            string     `json:"event"`
+		Status           string     `json:"status"`
+		Conclusion       string     `json:"conclusion"`
+		WorkflowID       int64      `json:"workflow_id"`
+		CheckSuiteID     int64      `json:"check_suite_id"`
+		CheckSuiteNodeID string     `json:"check_suite_node_id"`
+		URL              string     `json:"url"`
+		HTMLURL          string     `json:"html_url"`
+		CreatedAt        Timestamp  `json:"created_at"`
+		UpdatedAt        Timestamp  `json:"updated_at"`
+		RunAttempt       int64      `json:"run_attempt"`
+		RunStartedAt     Timestamp  `json:"run_started_at"`
+		JobsURL          string     `json:"jobs_url"`
+		LogsURL          string     `json:"logs_url"`
+		CheckSuiteURL    string     `json:"check_suite_url"`
+		ArtifactsURL     string     `json:"artifacts_url"`
+		CancelURL        string     `json:"cancel_url"`
+		RerunURL         string     `json:"rerun_url"`
+		WorkflowURL      string     `json:"workflow_url"`
+		HeadCommit       Commit     `json:"head_commit"`
+		Repository       Repository `json:"repository"`
+		HeadRepository   Repository `json:"head_repository"`
+	} `json:"workflow_run"`
+	Workflow struct {
+		ID        int64     `json:"id"`
+		NodeID    string    `json:"node_id"`
+		Name      string    `json:"name"`
+		Path      string    `json:"path"`
+		State     string    `json:"state"`
+		CreatedAt Timestamp `json:"created_at"`
+		UpdatedAt Timestamp `json:"updated_at"`
+		URL       string    `json:"url"`
+		HTMLURL   string    `json:"html_url"`
+		BadgeURL  string    `json:"badge_url"`
+	} `json:"workflow"`
+	Repository   Repository    `json:"repository"`
+	Organization Organization  `json:"organization,omitempty"`
+	Enterprise   Enterprise    `json:"enterprise,omitempty"`
+	Sender       User          `json:"sender"`
+	Installation *Installation `json:"installation,omitempty"`
+}
+
+// WorkflowRunAction is the action of the workflow_run event
+type WorkflowRunAction string
+
+// workflow_run actions
+const (
+	WorkflowRunActionCompleted  WorkflowRunAction = "completed"
+	WorkflowRunActionInProgress WorkflowRunAction = "in_progress"
+	WorkflowRunActionRequested  WorkflowRunAction = "requested"
+)
+
+// Event returns the workflow_run event restricted to the action, for filtering it in Parse
+func (a WorkflowRunAction) Event() Event {
+	return WorkflowRunEvent.WithAction(string(a))
+}
@@ -0,0 +1,104 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrFieldTypeMismatch is returned when reading a projects_v2_item field value change as the wrong field type
+var ErrFieldTypeMismatch = errors.New("field value change of another field type")
+
+// Projects (v2) field types reported by field value changes
+const (
+	ProjectsV2SingleSelectField = "single_select"
+	ProjectsV2IterationField    = "iteration"
+	ProjectsV2DateField         = "date"
+	ProjectsV2TextField         = "text"
+	ProjectsV2NumberField       = "number"
+)
+
+// ProjectsV2SingleSelectOption contains the option of a single select field
+type ProjectsV2SingleSelectOption struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// ProjectsV2Iteration contains the iteration of an iteration field
+type ProjectsV2Iteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	Duration  int64  `json:"duration"`
+}
+
+// SingleSelect returns the options before and after the change of a single select field, nil when unset
+func (c ProjectsV2FieldValueChange) SingleSelect() (from, to *ProjectsV2SingleSelectOption, err error) {
+	err = c.decode(ProjectsV2SingleSelectField, &from, &to)
+	return
+}
+
+// Iteration returns the iterations before and after the change of an iteration field, nil when unset
+func (c ProjectsV2FieldValueChange) Iteration() (from, to *ProjectsV2Iteration, err error) {
+	err = c.decode(ProjectsV2IterationField, &from, &to)
+	return
+}
+
+// Text returns the values before and after the change of a text field, nil when unset
+func (c ProjectsV2FieldValueChange) Text() (from, to *string, err error) {
+	err = c.decode(ProjectsV2TextField, &from, &to)
+	return
+}
+
+// Number returns the values before and after the change of a number field, nil when unset
+func (c ProjectsV2FieldValueChange) Number() (from, to *float64, err error) {
+	err = c.decode(ProjectsV2NumberField, &from, &to)
+	return
+}
+
+// Date returns the dates before and after the change of a date field, nil when unset
+func (c ProjectsV2FieldValueChange) Date() (from, to *time.Time, err error) {
+	var rawFrom, rawTo *string
+	if err = c.decode(ProjectsV2DateField, &rawFrom, &rawTo); err != nil {
+		return
+	}
+	if from, err = parseProjectsV2Date(rawFrom); err != nil {
+		return
+	}
+	to, err = parseProjectsV2Date(rawTo)
+	return
+}
+
+func (c ProjectsV2FieldValueChange) decode(fieldType string, from, to interface{}) error {
+	if c.FieldType != fieldType {
+		return fmt.Errorf("%w: %s", ErrFieldTypeMismatch, c.FieldType)
+	}
+	if len(c.From) > 0 {
+		if err := json.Unmarshal(c.From, from); err != nil {
+			return err
+		}
+	}
+	if len(c.To) > 0 {
+		if err := json.Unmarshal(c.To, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseProjectsV2Date accepts both the full timestamps and the plain dates sent for date fields
+func parseProjectsV2Date(value *string) (*time.Time, error) {
+	if value == nil || len(*value) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		if t, err = time.Parse("2006-01-02", *value); err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func loadFieldValueChange(t *testing.T, filename string) ProjectsV2FieldValueChange {
+	payload, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	var pl ProjectsV2ItemPayload
+	require.NoError(t, json.Unmarshal(payload, &pl))
+	require.NotNil(t, pl.Changes)
+	require.NotNil(t, pl.Changes.FieldValue)
+	return *pl.Changes.FieldValue
+}
+
+func TestProjectsV2FieldValueChange(t *testing.T) {
+	assert := require.New(t)
+
+	change := loadFieldValueChange(t, "../testdata/github/projects_v2_item_single_select.json")
+	assert.Equal("Status", change.FieldName)
+	from, to, err := change.SingleSelect()
+	assert.NoError(err)
+	assert.Equal("Todo", from.Name)
+	assert.Equal("47fc9ee4", to.ID)
+	assert.Equal("In Progress", to.Name)
+	_, _, err = change.Text()
+	assert.True(errors.Is(err, ErrFieldTypeMismatch))
+
+	change = loadFieldValueChange(t, "../testdata/github/projects_v2_item_iteration.json")
+	fromIteration, toIteration, err := change.Iteration()
+	assert.NoError(err)
+	assert.Nil(fromIteration)
+	assert.Equal(ProjectsV2Iteration{ID: "a4c2d1e0", Title: "Sprint 12", StartDate: "2023-10-02", Duration: 14}, *toIteration)
+
+	change = loadFieldValueChange(t, "../testdata/github/projects_v2_item_date.json")
+	fromDate, toDate, err := change.Date()
+	assert.NoError(err)
+	assert.True(fromDate.Equal(time.Date(2023, 10, 13, 0, 0, 0, 0, time.UTC)))
+	assert.True(toDate.Equal(time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC)))
+
+	change = loadFieldValueChange(t, "../testdata/github/projects_v2_item_text.json")
+	fromText, toText, err := change.Text()
+	assert.NoError(err)
+	assert.Equal("needs design", *fromText)
+	assert.Equal("design approved", *toText)
+
+	change = ProjectsV2FieldValueChange{FieldType: ProjectsV2NumberField, From: json.RawMessage(`null`), To: json.RawMessage(`3.5`)}
+	fromNumber, toNumber, err := change.Number()
+	assert.NoError(err)
+	assert.Nil(fromNumber)
+	assert.Equal(3.5, *toNumber)
+
+	change = ProjectsV2FieldValueChange{FieldType: ProjectsV2DateField, To: json.RawMessage(`"2023-10-20"`)}
+	fromDate, toDate, err = change.Date()
+	assert.NoError(err)
+	assert.Nil(fromDate)
+	assert.True(toDate.Equal(time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC)))
+}
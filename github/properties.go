@@ -0,0 +1,97 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Custom property value types
+const (
+	CustomPropertyString       = "string"
+	CustomPropertySingleSelect = "single_select"
+	CustomPropertyMultiSelect  = "multi_select"
+	CustomPropertyTrueFalse    = "true_false"
+)
+
+// Security feature statuses
+const (
+	SecurityFeatureEnabled  = "enabled"
+	SecurityFeatureDisabled = "disabled"
+)
+
+// PropertyValue contains the value of a custom property; GitHub sends a single string for every value
+// type but multi_select, which sends a list, and null once the value is removed, decoded as nil
+type PropertyValue []string
+
+// UnmarshalJSON decodes a custom property value sent as null, a string or a list of strings
+func (v *PropertyValue) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*v = nil
+		return nil
+	case len(data) > 0 && data[0] == '[':
+		var values []string
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		*v = values
+		return nil
+	default:
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		*v = PropertyValue{value}
+		return nil
+	}
+}
+
+// IsSet reports whether the property has a value
+func (v PropertyValue) IsSet() bool {
+	return v != nil
+}
+
+// String returns the value of a single value property, the values of a multi_select property joined with commas
+func (v PropertyValue) String() string {
+	return strings.Join(v, ",")
+}
+
+// Bool returns the value of a true_false property
+func (v PropertyValue) Bool() bool {
+	return len(v) == 1 && v[0] == "true"
+}
+
+// CustomPropertyChange contains the values of a custom property before and after a custom_property_values event
+type CustomPropertyChange struct {
+	PropertyName string
+	Old          PropertyValue
+	New          PropertyValue
+}
+
+// Changes pairs the old and new values of every property updated by the event, in the order of the new values
+// followed by the properties only present in the old values
+func (pl CustomPropertyValuesPayload) Changes() []CustomPropertyChange {
+	old := make(map[string]PropertyValue, len(pl.OldPropertyValues))
+	for _, p := range pl.OldPropertyValues {
+		old[p.PropertyName] = p.Value
+	}
+	changes := make([]CustomPropertyChange, 0, len(pl.NewPropertyValues))
+	seen := make(map[string]bool, len(pl.NewPropertyValues))
+	for _, p := range pl.NewPropertyValues {
+		seen[p.PropertyName] = true
+		changes = append(changes, CustomPropertyChange{PropertyName: p.PropertyName, Old: old[p.PropertyName], New: p.Value})
+	}
+	for _, p := range pl.OldPropertyValues {
+		if !seen[p.PropertyName] {
+			changes = append(changes, CustomPropertyChange{PropertyName: p.PropertyName, Old: p.Value})
+		}
+	}
+	return changes
+}
+
+// Enabled reports whether the security feature is enabled, false when GitHub did not report it
+func (f *SecurityFeature) Enabled() bool {
+	return f != nil && f.Status == SecurityFeatureEnabled
+}
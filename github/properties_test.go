@@ -0,0 +1,52 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomPropertyValues(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, CustomPropertyValuesEvent, "../testdata/github/custom_property_values.json").(CustomPropertyValuesPayload)
+	assert.Equal([]CustomPropertyChange{
+		{PropertyName: "environment", Old: PropertyValue{"production"}, New: PropertyValue{"development"}},
+		{PropertyName: "teams", Old: PropertyValue{"backend"}, New: PropertyValue{"backend", "platform"}},
+		{PropertyName: "compliance", Old: PropertyValue{"true"}},
+	}, pl.Changes())
+
+	changes := pl.Changes()
+	assert.Equal("backend,platform", changes[1].New.String())
+	assert.True(changes[2].Old.Bool())
+	assert.False(changes[2].New.IsSet())
+
+	definition := parseSigned(t, CustomPropertyEvent, "../testdata/github/custom_property.json").(CustomPropertyPayload).Definition
+	assert.Equal(CustomPropertySingleSelect, definition.ValueType)
+	assert.Equal(PropertyValue{"production"}, definition.DefaultValue)
+
+	var value CustomPropertyValue
+	assert.NoError(json.Unmarshal([]byte(`{"property_name":"environment","value":null}`), &value))
+	assert.False(value.Value.IsSet())
+	assert.Error(json.Unmarshal([]byte(`{"property_name":"environment","value":1}`), &value))
+}
+
+func TestSecurityAndAnalysis(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, SecurityAndAnalysisEvent, "../testdata/github/security_and_analysis.json").(SecurityAndAnalysisPayload)
+	from := pl.Changes.From.SecurityAndAnalysis
+	assert.NotNil(from)
+	assert.False(from.SecretScanningPushProtection.Enabled())
+	assert.False(from.DependabotSecurityUpdates.Enabled())
+	assert.Nil(from.AdvancedSecurity)
+	assert.False(from.AdvancedSecurity.Enabled())
+
+	current := pl.Repository.SecurityAndAnalysis
+	assert.NotNil(current)
+	assert.True(current.AdvancedSecurity.Enabled())
+	assert.True(current.SecretScanningPushProtection.Enabled())
+	assert.True(current.DependabotSecurityUpdates.Enabled())
+	assert.False(current.SecretScanningValidityChecks.Enabled())
+}
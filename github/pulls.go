@@ -0,0 +1,573 @@
+package github
+
+import (
+	"strings"
+	"sync"
+)
+
+// Review states
+const (
+	ReviewStateApproved         = "approved"
+	ReviewStateChangesRequested = "changes_requested"
+	ReviewStateCommented        = "commented"
+	ReviewStateDismissed        = "dismissed"
+)
+
+// CheckStatusCompleted is the status of completed check runs, check suites and workflow runs
+const CheckStatusCompleted = "completed"
+
+// PullRequestState contains the state of a pull request derived from the pull_request and pull_request_review
+// events
+type PullRequestState struct {
+	Repository     string
+	Number         int64
+	HeadSHA        string
+	BaseRef        string
+	State          string
+	Draft          bool
+	Merged         bool
+	Mergeable      *bool
+	MergeableState string
+	UpdatedAt      Timestamp
+
+	// Reviews contains the latest review deciding the approval of each reviewer, by login
+	Reviews map[string]ReviewState
+}
+
+// ReviewState contains the state of a pull request review
+type ReviewState struct {
+	ID          int64
+	State       string
+	SubmittedAt Timestamp
+}
+
+// CommitState contains the results of the checks and statuses of a commit, derived from the check_suite,
+// check_run, status and workflow_run events
+type CommitState struct {
+	Repository string
+	SHA        string
+
+	// CheckRuns contains the latest check run of each name
+	CheckRuns map[string]CheckResult
+
+	// CheckSuites contains the check suite of each GitHub App, by app ID; suites are only taken into account once
+	// a check run of their app is tracked, GitHub creating a queued suite for every app on each push
+	CheckSuites map[int64]CheckResult
+
+	// Statuses contains the latest commit status of each context
+	Statuses map[string]CheckResult
+
+	// WorkflowRuns contains the latest run of each workflow, by workflow ID
+	WorkflowRuns map[int64]CheckResult
+}
+
+// CheckResult contains the status and conclusion of a check run, check suite, workflow run or commit status;
+// commit statuses are completed unless pending, their state being the conclusion
+type CheckResult struct {
+	ID         int64
+	Name       string
+	Status     string
+	Conclusion string
+
+	// UpdatedAt is the completion time of check runs, or their start time until completed
+	UpdatedAt Timestamp
+
+	// AppID is the ID of the GitHub App of check runs and check suites
+	AppID int64
+}
+
+// PullRequestStatus contains the state of a pull request and the results of the checks of its head commit
+type PullRequestStatus struct {
+	PullRequestState
+	Commit CommitState
+}
+
+// PullRequestStore persists the states of a PullRequestTracker, it is only called by one goroutine at a time
+type PullRequestStore interface {
+	// LoadPullRequest returns the state of a pull request, found being false when not saved yet
+	LoadPullRequest(repo string, number int64) (state PullRequestState, found bool, err error)
+
+	SavePullRequest(state PullRequestState) error
+
+	// PullRequestsByHead returns the states of the pull requests whose head is the commit
+	PullRequestsByHead(repo, sha string) ([]PullRequestState, error)
+
+	// LoadCommit returns the state of a commit, found being false when not saved yet
+	LoadCommit(repo, sha string) (state CommitState, found bool, err error)
+
+	SaveCommit(state CommitState) error
+}
+
+// PullRequestTracker derives the state of pull requests, their reviews and the results of the checks of their
+// head commit from the pull_request, pull_request_review, check_suite, check_run, status and workflow_run events,
+// for answering whether a pull request is green and approved. Checks are correlated with the pull requests by
+// head SHA, so they can be delivered before the pull request event, and older events than the state are ignored.
+//
+// It is safe for concurrent use.
+type PullRequestTracker struct {
+	mu    sync.Mutex
+	store PullRequestStore
+}
+
+// NewPullRequestTracker returns a PullRequestTracker persisting its state in store, in memory when nil
+func NewPullRequestTracker(store PullRequestStore) *PullRequestTracker {
+	if store == nil {
+		store = NewMemoryPullRequestStore()
+	}
+	return &PullRequestTracker{store: store}
+}
+
+// Track updates the state with a parsed payload, returning the status of the pull requests affected by the
+// event; payloads of other events are ignored
+func (t *PullRequestTracker) Track(payload interface{}) ([]PullRequestStatus, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch pl := payload.(type) {
+	case PullRequestPayload:
+		return t.trackPullRequest(pl.Repository.FullName, pl.PullRequest, nil)
+	case PullRequestReviewPayload:
+		// the pull request of review events lacks its mergeability, only tracked from pull_request events
+		review := ReviewState{ID: pl.Review.ID, State: strings.ToLower(pl.Review.State), SubmittedAt: pl.Review.SubmittedAt}
+		if pl.Action == PullRequestReviewActionDismissed {
+			review.State = ReviewStateDismissed
+		}
+		return t.trackPullRequest(pl.Repository.FullName, pl.PullRequest, func(state *PullRequestState) {
+			state.review(pl.Review.User.Login, review)
+		})
+	case CheckSuitePayload:
+		suite := pl.CheckSuite
+		return t.trackCommit(pl.Repository.FullName, suite.HeadSHA, func(state *CommitState) {
+			result := CheckResult{
+				ID:         suite.ID,
+				Name:       suite.App.Name,
+				Status:     suite.Status,
+				Conclusion: suite.Conclusion,
+				UpdatedAt:  suite.UpdatedAt,
+				AppID:      suite.App.ID,
+			}
+			if current, ok := state.CheckSuites[suite.App.ID]; !ok || result.newer(current) {
+				state.CheckSuites[suite.App.ID] = result
+			}
+		})
+	case CheckRunPayload:
+		run := pl.CheckRun
+		return t.trackCommit(pl.Repository.FullName, run.HeadSHA, func(state *CommitState) {
+			result := CheckResult{
+				ID:         run.ID,
+				Name:       run.Name,
+				Status:     run.Status,
+				Conclusion: run.Conclusion,
+				UpdatedAt:  run.StartedAt,
+				AppID:      run.App.ID,
+			}
+			if result.Completed() {
+				result.UpdatedAt = run.CompletedAt
+			}
+			if current, ok := state.CheckRuns[run.Name]; !ok || result.newerRun(current) {
+				state.CheckRuns[run.Name] = result
+			}
+		})
+	case StatusPayload:
+		return t.trackCommit(pl.Repository.FullName, pl.Sha, func(state *CommitState) {
+			result := CheckResult{ID: pl.ID, Name: pl.Context, Status: CheckStatusCompleted, Conclusion: pl.State,
+				UpdatedAt: pl.UpdatedAt}
+			if pl.State == "pending" {
+				result.Status, result.Conclusion = "pending", ""
+			}
+			if current, ok := state.Statuses[pl.Context]; !ok || result.newer(current) {
+				state.Statuses[pl.Context] = result
+			}
+		})
+	case WorkflowRunPayload:
+		run := pl.WorkflowRun
+		return t.trackCommit(pl.Repository.FullName, run.HeadSha, func(state *CommitState) {
+			result := CheckResult{
+				ID:         run.ID,
+				Name:       run.Name,
+				Status:     run.Status,
+				Conclusion: run.Conclusion,
+				UpdatedAt:  run.UpdatedAt,
+			}
+			if current, ok := state.WorkflowRuns[run.WorkflowID]; !ok || result.newer(current) {
+				state.WorkflowRuns[run.WorkflowID] = result
+			}
+		})
+	}
+	return nil, nil
+}
+
+// Status returns the status of a pull request, found being false when no event was tracked for it
+func (t *PullRequestTracker) Status(repo string, number int64) (status PullRequestStatus, found bool, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, found, err := t.store.LoadPullRequest(repo, number)
+	if err != nil || !found {
+		return status, found, err
+	}
+	status, err = t.status(state)
+	return status, true, err
+}
+
+// GreenAndApproved reports whether a pull request is open, not a draft, green and approved, false when no event
+// was tracked for it
+func (t *PullRequestTracker) GreenAndApproved(repo string, number int64) (bool, error) {
+	status, found, err := t.Status(repo, number)
+	if err != nil || !found {
+		return false, err
+	}
+	return status.GreenAndApproved(), nil
+}
+
+// trackPullRequest updates the state of a pull request from a pull_request event, or from a pull_request_review
+// event when review is set
+func (t *PullRequestTracker) trackPullRequest(repo string, pr PullRequest, review func(*PullRequestState)) ([]PullRequestStatus, error) {
+	state, found, err := t.store.LoadPullRequest(repo, pr.Number)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		state = PullRequestState{Repository: repo, Number: pr.Number}
+	}
+	if state.Reviews == nil {
+		state.Reviews = make(map[string]ReviewState)
+	}
+
+	if !pr.UpdatedAt.Before(state.UpdatedAt.Time) {
+		state.HeadSHA = pr.Head.Sha
+		state.State = pr.State
+		state.Draft = pr.Draft
+		state.UpdatedAt = pr.UpdatedAt
+		if review == nil {
+			state.BaseRef = pr.Base.Ref
+			state.Merged = pr.Merged
+			state.Mergeable = pr.Mergeable
+			state.MergeableState = pr.MergeableState
+		}
+	}
+	if review != nil {
+		review(&state)
+	}
+	if err := t.store.SavePullRequest(state); err != nil {
+		return nil, err
+	}
+
+	status, err := t.status(state)
+	if err != nil {
+		return nil, err
+	}
+	return []PullRequestStatus{status}, nil
+}
+
+func (t *PullRequestTracker) trackCommit(repo, sha string, update func(*CommitState)) ([]PullRequestStatus, error) {
+	state, found, err := t.store.LoadCommit(repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		state = CommitState{Repository: repo, SHA: sha}
+	}
+	state.init()
+	update(&state)
+	if err := t.store.SaveCommit(state); err != nil {
+		return nil, err
+	}
+
+	pulls, err := t.store.PullRequestsByHead(repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]PullRequestStatus, 0, len(pulls))
+	for _, pull := range pulls {
+		statuses = append(statuses, PullRequestStatus{PullRequestState: pull, Commit: state})
+	}
+	return statuses, nil
+}
+
+func (t *PullRequestTracker) status(state PullRequestState) (PullRequestStatus, error) {
+	status := PullRequestStatus{PullRequestState: state}
+	commit, found, err := t.store.LoadCommit(state.Repository, state.HeadSHA)
+	if err != nil {
+		return status, err
+	}
+	if !found {
+		commit = CommitState{Repository: state.Repository, SHA: state.HeadSHA}
+	}
+	commit.init()
+	status.Commit = commit
+	return status, nil
+}
+
+// review records the review of a reviewer, comments not changing the decision of a previous review
+func (s *PullRequestState) review(login string, review ReviewState) {
+	current, ok := s.Reviews[login]
+	switch {
+	case review.State == ReviewStateDismissed:
+		if ok && current.ID == review.ID {
+			s.Reviews[login] = review
+		}
+	case ok && (review.ID < current.ID || review.State == ReviewStateCommented):
+		// older review delivered late, or comment
+	default:
+		s.Reviews[login] = review
+	}
+}
+
+func (s *CommitState) init() {
+	if s.CheckRuns == nil {
+		s.CheckRuns = make(map[string]CheckResult)
+	}
+	if s.CheckSuites == nil {
+		s.CheckSuites = make(map[int64]CheckResult)
+	}
+	if s.Statuses == nil {
+		s.Statuses = make(map[string]CheckResult)
+	}
+	if s.WorkflowRuns == nil {
+		s.WorkflowRuns = make(map[int64]CheckResult)
+	}
+}
+
+// newer reports whether the result replaces the current one, being of a later check, updated later, or further
+// in the checks lifecycle
+func (r CheckResult) newer(current CheckResult) bool {
+	if r.ID != current.ID {
+		return r.ID > current.ID
+	}
+	if !r.UpdatedAt.Equal(current.UpdatedAt.Time) {
+		return r.UpdatedAt.After(current.UpdatedAt.Time)
+	}
+	return checkRank(r.Status) >= checkRank(current.Status)
+}
+
+// newerRun reports whether the check run result replaces the current one, being of a later run or further in the
+// lifecycle of the run, the completion or start time ordering the results of the same status
+func (r CheckResult) newerRun(current CheckResult) bool {
+	if r.ID != current.ID {
+		return r.ID > current.ID
+	}
+	if rank, currentRank := checkRank(r.Status), checkRank(current.Status); rank != currentRank {
+		return rank > currentRank
+	}
+	return !r.UpdatedAt.Before(current.UpdatedAt.Time)
+}
+
+// Completed reports whether the check is completed
+func (r CheckResult) Completed() bool {
+	return r.Status == CheckStatusCompleted
+}
+
+// Succeeded reports whether the check completed with a success, neutral or skipped conclusion
+func (r CheckResult) Succeeded() bool {
+	if !r.Completed() {
+		return false
+	}
+	switch r.Conclusion {
+	case "success", "neutral", "skipped":
+		return true
+	}
+	return false
+}
+
+// results returns every check result of the commit, leaving out the check suites of the apps without check runs
+func (s CommitState) results() []CheckResult {
+	results := make([]CheckResult, 0, len(s.CheckRuns)+len(s.CheckSuites)+len(s.Statuses)+len(s.WorkflowRuns))
+	apps := make(map[int64]bool, len(s.CheckRuns))
+	for _, result := range s.CheckRuns {
+		results = append(results, result)
+		apps[result.AppID] = true
+	}
+	for appID, result := range s.CheckSuites {
+		if apps[appID] {
+			results = append(results, result)
+		}
+	}
+	for _, result := range s.Statuses {
+		results = append(results, result)
+	}
+	for _, result := range s.WorkflowRuns {
+		results = append(results, result)
+	}
+	return results
+}
+
+// Green reports whether every check of the commit succeeded, at least one check being reported
+func (s CommitState) Green() bool {
+	results := s.results()
+	for _, result := range results {
+		if !result.Succeeded() {
+			return false
+		}
+	}
+	return len(results) > 0
+}
+
+// Pending reports whether a check of the commit is not completed
+func (s CommitState) Pending() bool {
+	for _, result := range s.results() {
+		if !result.Completed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed reports whether a check of the commit completed without succeeding
+func (s CommitState) Failed() bool {
+	for _, result := range s.results() {
+		if result.Completed() && !result.Succeeded() {
+			return true
+		}
+	}
+	return false
+}
+
+// Approvals returns the number of reviewers whose latest review approves the pull request
+func (s PullRequestState) Approvals() int {
+	var approvals int
+	for _, review := range s.Reviews {
+		if review.State == ReviewStateApproved {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// Approved reports whether the pull request is approved by a reviewer, without changes requested by another
+func (s PullRequestState) Approved() bool {
+	for _, review := range s.Reviews {
+		if review.State == ReviewStateChangesRequested {
+			return false
+		}
+	}
+	return s.Approvals() > 0
+}
+
+// Open reports whether the pull request is open and not a draft
+func (s PullRequestState) Open() bool {
+	return s.State == "open" && !s.Draft
+}
+
+// Green reports whether every check of the head commit succeeded
+func (s PullRequestStatus) Green() bool {
+	return s.Commit.Green()
+}
+
+// GreenAndApproved reports whether the pull request is open, not a draft, not known to conflict, approved and
+// every check of its head commit succeeded
+func (s PullRequestStatus) GreenAndApproved() bool {
+	if s.Mergeable != nil && !*s.Mergeable {
+		return false
+	}
+	return s.Open() && s.Approved() && s.Green()
+}
+
+// checkRank orders the statuses of the checks in their lifecycle
+func checkRank(status string) int {
+	switch status {
+	case CheckStatusCompleted:
+		return 2
+	case "in_progress":
+		return 1
+	}
+	return 0
+}
+
+// MemoryPullRequestStore is a PullRequestStore keeping the states in memory
+type MemoryPullRequestStore struct {
+	pulls   map[string]map[int64]PullRequestState
+	heads   map[string]map[string]map[int64]bool
+	commits map[string]map[string]CommitState
+}
+
+// NewMemoryPullRequestStore returns an empty MemoryPullRequestStore
+func NewMemoryPullRequestStore() *MemoryPullRequestStore {
+	return &MemoryPullRequestStore{
+		pulls:   make(map[string]map[int64]PullRequestState),
+		heads:   make(map[string]map[string]map[int64]bool),
+		commits: make(map[string]map[string]CommitState),
+	}
+}
+
+// LoadPullRequest returns a copy of the saved state of a pull request
+func (m *MemoryPullRequestStore) LoadPullRequest(repo string, number int64) (PullRequestState, bool, error) {
+	state, ok := m.pulls[repo][number]
+	return state.clone(), ok, nil
+}
+
+// SavePullRequest saves a copy of the state of a pull request
+func (m *MemoryPullRequestStore) SavePullRequest(state PullRequestState) error {
+	if m.pulls[state.Repository] == nil {
+		m.pulls[state.Repository] = make(map[int64]PullRequestState)
+		m.heads[state.Repository] = make(map[string]map[int64]bool)
+	}
+	if previous, ok := m.pulls[state.Repository][state.Number]; ok {
+		delete(m.heads[state.Repository][previous.HeadSHA], state.Number)
+	}
+	m.pulls[state.Repository][state.Number] = state.clone()
+
+	heads := m.heads[state.Repository]
+	if heads[state.HeadSHA] == nil {
+		heads[state.HeadSHA] = make(map[int64]bool)
+	}
+	heads[state.HeadSHA][state.Number] = true
+	return nil
+}
+
+// PullRequestsByHead returns copies of the saved states of the pull requests whose head is the commit
+func (m *MemoryPullRequestStore) PullRequestsByHead(repo, sha string) ([]PullRequestState, error) {
+	var states []PullRequestState
+	for number := range m.heads[repo][sha] {
+		states = append(states, m.pulls[repo][number].clone())
+	}
+	return states, nil
+}
+
+// LoadCommit returns a copy of the saved state of a commit
+func (m *MemoryPullRequestStore) LoadCommit(repo, sha string) (CommitState, bool, error) {
+	state, ok := m.commits[repo][sha]
+	return state.clone(), ok, nil
+}
+
+// SaveCommit saves a copy of the state of a commit
+func (m *MemoryPullRequestStore) SaveCommit(state CommitState) error {
+	if m.commits[state.Repository] == nil {
+		m.commits[state.Repository] = make(map[string]CommitState)
+	}
+	m.commits[state.Repository][state.SHA] = state.clone()
+	return nil
+}
+
+func (s PullRequestState) clone() PullRequestState {
+	if s.Reviews != nil {
+		reviews := make(map[string]ReviewState, len(s.Reviews))
+		for login, review := range s.Reviews {
+			reviews[login] = review
+		}
+		s.Reviews = reviews
+	}
+	if s.Mergeable != nil {
+		mergeable := *s.Mergeable
+		s.Mergeable = &mergeable
+	}
+	return s
+}
+
+func (s CommitState) clone() CommitState {
+	clone := CommitState{Repository: s.Repository, SHA: s.SHA}
+	clone.init()
+	for name, result := range s.CheckRuns {
+		clone.CheckRuns[name] = result
+	}
+	for id, result := range s.CheckSuites {
+		clone.CheckSuites[id] = result
+	}
+	for context, result := range s.Statuses {
+		clone.Statuses[context] = result
+	}
+	for id, result := range s.WorkflowRuns {
+		clone.WorkflowRuns[id] = result
+	}
+	return clone
+}
@@ -0,0 +1,338 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const trackedRepo = "Codertocat/Hello-World"
+
+func pullRequestEvent(sha string, updatedAt time.Time) PullRequestPayload {
+	var pl PullRequestPayload
+	pl.Action = PullRequestActionSynchronize
+	pl.Repository.FullName = trackedRepo
+	pl.PullRequest.Number = 2
+	pl.PullRequest.State = "open"
+	pl.PullRequest.Head.Sha = sha
+	pl.PullRequest.Base.Ref = "master"
+	pl.PullRequest.UpdatedAt = Timestamp{updatedAt}
+	return pl
+}
+
+func reviewEvent(pr PullRequestPayload, id int64, login, state string) PullRequestReviewPayload {
+	var pl PullRequestReviewPayload
+	pl.Action = PullRequestReviewActionSubmitted
+	pl.Repository = pr.Repository
+	pl.PullRequest = pr.PullRequest
+	pl.Review.ID = id
+	pl.Review.User.Login = login
+	pl.Review.State = state
+	return pl
+}
+
+func checkRunEvent(sha string, id int64, name, status, conclusion string) CheckRunPayload {
+	var pl CheckRunPayload
+	pl.Repository.FullName = trackedRepo
+	pl.CheckRun.ID = id
+	pl.CheckRun.Name = name
+	pl.CheckRun.HeadSHA = sha
+	pl.CheckRun.Status = status
+	pl.CheckRun.Conclusion = conclusion
+	return pl
+}
+
+func statusEvent(sha string, id int64, context, state string) StatusPayload {
+	var pl StatusPayload
+	pl.Repository.FullName = trackedRepo
+	pl.ID = id
+	pl.Sha = sha
+	pl.Context = context
+	pl.State = state
+	return pl
+}
+
+func TestPullRequestTracker(t *testing.T) {
+	assert := require.New(t)
+
+	const (
+		first  = "ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+		second = "34c5c7793cb3b279e22454cb6750c80560547b3a"
+	)
+	updatedAt := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+	tracker := NewPullRequestTracker(nil)
+
+	// the check run is delivered before the pull request
+	statuses, err := tracker.Track(checkRunEvent(first, 1, "lint", CheckStatusCompleted, "success"))
+	assert.NoError(err)
+	assert.Empty(statuses)
+
+	opened := pullRequestEvent(first, updatedAt)
+	statuses, err = tracker.Track(opened)
+	assert.NoError(err)
+	assert.Len(statuses, 1)
+	assert.True(statuses[0].Green())
+	assert.False(statuses[0].Approved())
+
+	ok, err := tracker.GreenAndApproved(trackedRepo, 2)
+	assert.NoError(err)
+	assert.False(ok)
+
+	_, err = tracker.Track(reviewEvent(opened, 10, "alice", "APPROVED"))
+	assert.NoError(err)
+	ok, err = tracker.GreenAndApproved(trackedRepo, 2)
+	assert.NoError(err)
+	assert.True(ok)
+
+	// a pending commit status holds the pull request
+	statuses, err = tracker.Track(statusEvent(first, 100, "ci/legacy", "pending"))
+	assert.NoError(err)
+	assert.Len(statuses, 1)
+	assert.True(statuses[0].Commit.Pending())
+	assert.False(statuses[0].GreenAndApproved())
+
+	statuses, err = tracker.Track(statusEvent(first, 101, "ci/legacy", "success"))
+	assert.NoError(err)
+	assert.True(statuses[0].GreenAndApproved())
+	// the pending status delivered again late
+	statuses, err = tracker.Track(statusEvent(first, 100, "ci/legacy", "pending"))
+	assert.NoError(err)
+	assert.True(statuses[0].GreenAndApproved())
+
+	// a new run of the check failing
+	_, err = tracker.Track(checkRunEvent(first, 2, "lint", CheckStatusCompleted, "failure"))
+	assert.NoError(err)
+	status, found, err := tracker.Status(trackedRepo, 2)
+	assert.NoError(err)
+	assert.True(found)
+	assert.True(status.Commit.Failed())
+	assert.False(status.GreenAndApproved())
+	_, err = tracker.Track(checkRunEvent(first, 1, "lint", CheckStatusCompleted, "success"))
+	assert.NoError(err)
+	status, _, err = tracker.Status(trackedRepo, 2)
+	assert.NoError(err)
+	assert.False(status.Green())
+
+	// new commits pushed, the older pull_request event delivered late being ignored
+	_, err = tracker.Track(pullRequestEvent(second, updatedAt.Add(time.Hour)))
+	assert.NoError(err)
+	_, err = tracker.Track(pullRequestEvent(first, updatedAt.Add(time.Minute)))
+	assert.NoError(err)
+	status, _, err = tracker.Status(trackedRepo, 2)
+	assert.NoError(err)
+	assert.Equal(second, status.HeadSHA)
+	assert.False(status.Green())
+	assert.False(status.Commit.Pending())
+
+	statuses, err = tracker.Track(checkRunEvent(second, 3, "lint", "in_progress", ""))
+	assert.NoError(err)
+	assert.Len(statuses, 1)
+	assert.True(statuses[0].Commit.Pending())
+	statuses, err = tracker.Track(checkRunEvent(second, 3, "lint", CheckStatusCompleted, "neutral"))
+	assert.NoError(err)
+	assert.True(statuses[0].GreenAndApproved())
+
+	_, found, err = tracker.Status(trackedRepo, 3)
+	assert.NoError(err)
+	assert.False(found)
+}
+
+func TestPullRequestTrackerReviews(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewPullRequestTracker(nil)
+	pr := pullRequestEvent("ec26c3e57ca3a959ca5aad62de7213c562f8c821", time.Now())
+
+	approved := func() bool {
+		status, _, err := tracker.Status(trackedRepo, 2)
+		assert.NoError(err)
+		return status.Approved()
+	}
+
+	_, err := tracker.Track(reviewEvent(pr, 1, "alice", "commented"))
+	assert.NoError(err)
+	assert.False(approved())
+	_, err = tracker.Track(reviewEvent(pr, 2, "alice", "approved"))
+	assert.NoError(err)
+	assert.True(approved())
+	_, err = tracker.Track(reviewEvent(pr, 3, "bob", "changes_requested"))
+	assert.NoError(err)
+	assert.False(approved())
+
+	// comments do not change the decision of a reviewer
+	_, err = tracker.Track(reviewEvent(pr, 4, "bob", "commented"))
+	assert.NoError(err)
+	assert.False(approved())
+	_, err = tracker.Track(reviewEvent(pr, 5, "bob", "approved"))
+	assert.NoError(err)
+	assert.True(approved())
+	// older review delivered late
+	_, err = tracker.Track(reviewEvent(pr, 3, "bob", "changes_requested"))
+	assert.NoError(err)
+	assert.True(approved())
+
+	dismissed := reviewEvent(pr, 2, "alice", "dismissed")
+	dismissed.Action = PullRequestReviewActionDismissed
+	_, err = tracker.Track(dismissed)
+	assert.NoError(err)
+	status, _, err := tracker.Status(trackedRepo, 2)
+	assert.NoError(err)
+	assert.Equal(1, status.Approvals())
+	assert.Equal(ReviewStateDismissed, status.Reviews["alice"].State)
+}
+
+func TestPullRequestTrackerMergeability(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewPullRequestTracker(nil)
+	const sha = "ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+	updatedAt := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+
+	conflicting := pullRequestEvent(sha, updatedAt)
+	mergeable := false
+	conflicting.PullRequest.Mergeable = &mergeable
+	conflicting.PullRequest.MergeableState = "dirty"
+	_, err := tracker.Track(conflicting)
+	assert.NoError(err)
+	_, err = tracker.Track(checkRunEvent(sha, 1, "lint", CheckStatusCompleted, "success"))
+	assert.NoError(err)
+
+	// the pull request of the review carries no mergeability
+	review := reviewEvent(pullRequestEvent(sha, updatedAt.Add(time.Minute)), 10, "alice", "approved")
+	statuses, err := tracker.Track(review)
+	assert.NoError(err)
+	assert.Len(statuses, 1)
+	assert.True(statuses[0].Approved())
+	assert.True(statuses[0].Green())
+	assert.NotNil(statuses[0].Mergeable)
+	assert.False(*statuses[0].Mergeable)
+	assert.Equal("dirty", statuses[0].MergeableState)
+	assert.Equal(updatedAt.Add(time.Minute), statuses[0].UpdatedAt.Time)
+	assert.False(statuses[0].GreenAndApproved())
+}
+
+func TestPullRequestTrackerCheckRuns(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewPullRequestTracker(nil)
+	const sha = "ec26c3e57ca3a959ca5aad62de7213c562f8c821"
+	startedAt := time.Date(2023, 10, 2, 8, 30, 0, 0, time.UTC)
+	_, err := tracker.Track(reviewEvent(pullRequestEvent(sha, startedAt), 10, "alice", "approved"))
+	assert.NoError(err)
+
+	// an app creating no check runs leaves its suite queued
+	var queued CheckSuitePayload
+	queued.Repository.FullName = trackedRepo
+	queued.CheckSuite.ID = 5
+	queued.CheckSuite.HeadSHA = sha
+	queued.CheckSuite.Status = "queued"
+	queued.CheckSuite.App.ID = 1
+	_, err = tracker.Track(queued)
+	assert.NoError(err)
+
+	inProgress := checkRunEvent(sha, 7, "lint", "in_progress", "")
+	inProgress.CheckRun.App.ID = 2
+	inProgress.CheckRun.StartedAt = Timestamp{startedAt}
+	completed := checkRunEvent(sha, 7, "lint", CheckStatusCompleted, "success")
+	completed.CheckRun.App.ID = 2
+	completed.CheckRun.StartedAt = Timestamp{startedAt}
+	completed.CheckRun.CompletedAt = Timestamp{startedAt.Add(2 * time.Minute)}
+	for _, pl := range []CheckRunPayload{inProgress, completed} {
+		_, err = tracker.Track(pl)
+		assert.NoError(err)
+	}
+	ok, err := tracker.GreenAndApproved(trackedRepo, 2)
+	assert.NoError(err)
+	assert.True(ok)
+
+	// older deliveries of the same run arriving late
+	stale := checkRunEvent(sha, 7, "lint", CheckStatusCompleted, "failure")
+	stale.CheckRun.App.ID = 2
+	stale.CheckRun.CompletedAt = Timestamp{startedAt.Add(time.Minute)}
+	for _, pl := range []CheckRunPayload{inProgress, stale} {
+		statuses, err := tracker.Track(pl)
+		assert.NoError(err)
+		assert.Len(statuses, 1)
+		assert.True(statuses[0].GreenAndApproved())
+		assert.Equal("success", statuses[0].Commit.CheckRuns["lint"].Conclusion)
+	}
+
+	// the suite counts once its app runs a check
+	run := checkRunEvent(sha, 8, "build", "queued", "")
+	run.CheckRun.App.ID = 1
+	statuses, err := tracker.Track(run)
+	assert.NoError(err)
+	assert.True(statuses[0].Commit.Pending())
+	run.CheckRun.Status, run.CheckRun.Conclusion = CheckStatusCompleted, "success"
+	statuses, err = tracker.Track(run)
+	assert.NoError(err)
+	assert.False(statuses[0].Green())
+
+	queued.CheckSuite.Status, queued.CheckSuite.Conclusion = CheckStatusCompleted, "success"
+	queued.CheckSuite.UpdatedAt = Timestamp{startedAt.Add(3 * time.Minute)}
+	statuses, err = tracker.Track(queued)
+	assert.NoError(err)
+	assert.True(statuses[0].GreenAndApproved())
+}
+
+func TestPullRequestTrackerFixtures(t *testing.T) {
+	assert := require.New(t)
+
+	tracker := NewPullRequestTracker(NewMemoryPullRequestStore())
+	for _, event := range []struct {
+		event    Event
+		filename string
+	}{
+		{event: PullRequestEvent, filename: "../testdata/github/pull-request.json"},
+		{event: PullRequestReviewEvent, filename: "../testdata/github/pull-request-review.json"},
+		{event: CheckSuiteEvent, filename: "../testdata/github/check_suite_rerequested.json"},
+		{event: WorkflowRunEvent, filename: "../testdata/github/workflow_run.json"},
+		{event: StatusEvent, filename: "../testdata/github/status.json"},
+		{event: PushEvent, filename: "../testdata/github/push.json"},
+	} {
+		_, err := tracker.Track(parseSigned(t, event.event, event.filename))
+		assert.NoError(err, event.filename)
+	}
+
+	pl := parseSigned(t, PullRequestEvent, "../testdata/github/pull-request.json").(PullRequestPayload)
+	status, found, err := tracker.Status(pl.Repository.FullName, pl.PullRequest.Number)
+	assert.NoError(err)
+	assert.True(found)
+	assert.Equal(pl.PullRequest.Head.Sha, status.HeadSHA)
+	assert.Equal("open", status.State)
+}
+
+func TestMemoryPullRequestStore(t *testing.T) {
+	assert := require.New(t)
+
+	store := NewMemoryPullRequestStore()
+	assert.NoError(store.SavePullRequest(PullRequestState{
+		Repository: trackedRepo,
+		Number:     2,
+		HeadSHA:    "a",
+		Reviews:    map[string]ReviewState{"alice": {ID: 1, State: ReviewStateApproved}},
+	}))
+
+	// saved states are copies
+	state, found, err := store.LoadPullRequest(trackedRepo, 2)
+	assert.NoError(err)
+	assert.True(found)
+	state.Reviews["alice"] = ReviewState{ID: 2, State: ReviewStateChangesRequested}
+	state, _, err = store.LoadPullRequest(trackedRepo, 2)
+	assert.NoError(err)
+	assert.Equal(ReviewStateApproved, state.Reviews["alice"].State)
+
+	state.HeadSHA = "b"
+	assert.NoError(store.SavePullRequest(state))
+	pulls, err := store.PullRequestsByHead(trackedRepo, "a")
+	assert.NoError(err)
+	assert.Empty(pulls)
+	pulls, err = store.PullRequestsByHead(trackedRepo, "b")
+	assert.NoError(err)
+	assert.Len(pulls, 1)
+
+	_, found, err = store.LoadCommit(trackedRepo, "b")
+	assert.NoError(err)
+	assert.False(found)
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrRuleTypeMismatch is returned when decoding the parameters of a ruleset rule as another rule type
+var ErrRuleTypeMismatch = errors.New("parameters of another rule type")
+
+// Ruleset rule types
+const (
+	RulesetRuleCreation                 = "creation"
+	RulesetRuleUpdate                   = "update"
+	RulesetRuleDeletion                 = "deletion"
+	RulesetRuleRequiredLinearHistory    = "required_linear_history"
+	RulesetRuleMergeQueue               = "merge_queue"
+	RulesetRuleRequiredDeployments      = "required_deployments"
+	RulesetRuleRequiredSignatures       = "required_signatures"
+	RulesetRulePullRequest              = "pull_request"
+	RulesetRuleRequiredStatusChecks     = "required_status_checks"
+	RulesetRuleNonFastForward           = "non_fast_forward"
+	RulesetRuleCommitMessagePattern     = "commit_message_pattern"
+	RulesetRuleCommitAuthorEmailPattern = "commit_author_email_pattern"
+	RulesetRuleCommitterEmailPattern    = "committer_email_pattern"
+	RulesetRuleBranchNamePattern        = "branch_name_pattern"
+	RulesetRuleTagNamePattern           = "tag_name_pattern"
+)
+
+// PullRequestParameters decodes the parameters of a pull_request rule
+func (r RulesetRule) PullRequestParameters() (params RulesetRulePullRequestParameters, err error) {
+	err = r.decode(&params, RulesetRulePullRequest)
+	return
+}
+
+// RequiredStatusChecksParameters decodes the parameters of a required_status_checks rule
+func (r RulesetRule) RequiredStatusChecksParameters() (params RulesetRuleRequiredStatusChecksParameters, err error) {
+	err = r.decode(&params, RulesetRuleRequiredStatusChecks)
+	return
+}
+
+// UpdateParameters decodes the parameters of an update rule
+func (r RulesetRule) UpdateParameters() (params RulesetRuleUpdateParameters, err error) {
+	err = r.decode(&params, RulesetRuleUpdate)
+	return
+}
+
+// RequiredDeploymentsParameters decodes the parameters of a required_deployments rule
+func (r RulesetRule) RequiredDeploymentsParameters() (params RulesetRuleRequiredDeploymentsParameters, err error) {
+	err = r.decode(&params, RulesetRuleRequiredDeployments)
+	return
+}
+
+// MergeQueueParameters decodes the parameters of a merge_queue rule
+func (r RulesetRule) MergeQueueParameters() (params RulesetRuleMergeQueueParameters, err error) {
+	err = r.decode(&params, RulesetRuleMergeQueue)
+	return
+}
+
+// PatternParameters decodes the parameters of the commit_message_pattern, commit_author_email_pattern,
+// committer_email_pattern, branch_name_pattern and tag_name_pattern rules
+func (r RulesetRule) PatternParameters() (params RulesetRulePatternParameters, err error) {
+	err = r.decode(&params, RulesetRuleCommitMessagePattern, RulesetRuleCommitAuthorEmailPattern,
+		RulesetRuleCommitterEmailPattern, RulesetRuleBranchNamePattern, RulesetRuleTagNamePattern)
+	return
+}
+
+func (r RulesetRule) decode(params interface{}, types ...string) error {
+	var found bool
+	for _, typ := range types {
+		if r.Type == typ {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrRuleTypeMismatch, r.Type)
+	}
+	if len(r.Parameters) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Parameters, params)
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesetRuleParameters(t *testing.T) {
+	assert := require.New(t)
+
+	payload, err := os.ReadFile("../testdata/github/repository_ruleset.json")
+	assert.NoError(err)
+	var pl RepositoryRulesetPayload
+	assert.NoError(json.Unmarshal(payload, &pl))
+
+	rules := pl.RepositoryRuleset.Rules
+	assert.Len(rules, 5)
+
+	_, err = rules[0].PullRequestParameters()
+	assert.True(errors.Is(err, ErrRuleTypeMismatch))
+
+	pr, err := rules[2].PullRequestParameters()
+	assert.NoError(err)
+	assert.Equal(int64(1), pr.RequiredApprovingReviewCount)
+	assert.True(pr.RequireCodeOwnerReview)
+
+	checks, err := rules[3].RequiredStatusChecksParameters()
+	assert.NoError(err)
+	assert.Len(checks.RequiredStatusChecks, 1)
+	assert.Equal("ci/test", checks.RequiredStatusChecks[0].Context)
+	assert.True(checks.StrictRequiredStatusChecksPolicy)
+
+	pattern, err := rules[4].PatternParameters()
+	assert.NoError(err)
+	assert.Equal("regex", pattern.Operator)
+	assert.Equal("^(feat|fix|chore)", pattern.Pattern)
+}
+
+func TestBranchProtectionRuleChanges(t *testing.T) {
+	assert := require.New(t)
+
+	payload, err := os.ReadFile("../testdata/github/branch_protection_rule_edited.json")
+	assert.NoError(err)
+	var pl BranchProtectionRulePayload
+	assert.NoError(json.Unmarshal(payload, &pl))
+
+	assert.NotNil(pl.Changes)
+	assert.NotNil(pl.Changes.AdminEnforced)
+	assert.True(pl.Changes.AdminEnforced.From)
+	assert.Equal(int64(2), pl.Changes.RequiredApprovingReviewCount.From)
+	assert.Equal([]string{"ci/test", "ci/lint"}, pl.Changes.RequiredStatusChecks.From)
+	assert.Nil(pl.Changes.LockBranchEnforcementLevel)
+}
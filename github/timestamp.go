@@ -0,0 +1,76 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts are the layouts tried after RFC 3339, such as the ones of the GitHub App timestamps and the
+// dates of projects, which carry no time of day
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp is a time sent by GitHub, decoded from either an ISO 8601 string or the Unix epoch seconds some
+// events, such as push, use for the repository created_at and pushed_at fields
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes the time from an ISO 8601 string, Unix epoch seconds or null
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		seconds, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return err
+		}
+		t.Time = time.Unix(seconds, 0).UTC()
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := parseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// MarshalJSON encodes the time as an RFC 3339 string, null when unset
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return parsed, nil
+	}
+	for _, layout := range timestampLayouts {
+		if parsed, layoutErr := time.Parse(layout, value); layoutErr == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, err
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected time.Time
+		err      bool
+	}{
+		{
+			name:     "RFC3339",
+			data:     `"2019-05-15T15:20:30Z"`,
+			expected: time.Date(2019, 5, 15, 15, 20, 30, 0, time.UTC),
+		},
+		{
+			name:     "RFC3339Offset",
+			data:     `"2018-06-29T19:34:13+05:30"`,
+			expected: time.Date(2018, 6, 29, 14, 4, 13, 0, time.UTC),
+		},
+		{
+			name:     "Epoch",
+			data:     `1557933565`,
+			expected: time.Date(2019, 5, 15, 15, 19, 25, 0, time.UTC),
+		},
+		{
+			name:     "AppTimestamp",
+			data:     `"2018-04-25 20:42:10"`,
+			expected: time.Date(2018, 4, 25, 20, 42, 10, 0, time.UTC),
+		},
+		{
+			name:     "Date",
+			data:     `"2023-10-20"`,
+			expected: time.Date(2023, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Null",
+			data: `null`,
+		},
+		{
+			name: "Empty",
+			data: `""`,
+		},
+		{
+			name: "Invalid",
+			data: `"yesterday"`,
+			err:  true,
+		},
+		{
+			name: "InvalidNumber",
+			data: `15.5`,
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			var ts Timestamp
+			err := json.Unmarshal([]byte(tc.data), &ts)
+			if tc.err {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.True(tc.expected.Equal(ts.Time), "expected %s, got %s", tc.expected, ts.Time)
+
+			// round-trips through MarshalJSON
+			data, err := json.Marshal(ts)
+			assert.NoError(err)
+			var decoded Timestamp
+			assert.NoError(json.Unmarshal(data, &decoded))
+			assert.True(ts.Time.Equal(decoded.Time))
+		})
+	}
+}
+
+func TestTimestampPushRepository(t *testing.T) {
+	assert := require.New(t)
+
+	pl := parseSigned(t, PushEvent, "../testdata/github/push.json").(PushPayload)
+	assert.False(pl.Repository.CreatedAt.IsZero())
+	assert.False(pl.Repository.PushedAt.IsZero())
+	assert.False(pl.Repository.UpdatedAt.IsZero())
+	assert.True(pl.Repository.PushedAt.After(pl.Repository.CreatedAt.Time))
+}
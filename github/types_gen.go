@@ -0,0 +1,852 @@
+// Code generated by schemagen from the webhook schemas. DO NOT EDIT.
+
+package github
+
+import "encoding/json"
+
+// App contains the information of the GitHub App owning a check suite or check run
+type App struct {
+	ID          int64             `json:"id"`
+	Slug        string            `json:"slug"`
+	NodeID      string            `json:"node_id"`
+	ClientID    string            `json:"client_id"`
+	Owner       AppOwner          `json:"owner"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	ExternalURL string            `json:"external_url"`
+	HTMLURL     string            `json:"html_url"`
+	CreatedAt   Timestamp         `json:"created_at"`
+	UpdatedAt   Timestamp         `json:"updated_at"`
+	Permissions map[string]string `json:"permissions"`
+	Events      []string          `json:"events"`
+}
+
+// AppOwner is the user or organization owning a GitHub App, with the fields of both
+type AppOwner struct {
+	Login             string  `json:"login"`
+	ID                int64   `json:"id"`
+	NodeID            string  `json:"node_id"`
+	Name              string  `json:"name,omitempty"`
+	Email             *string `json:"email,omitempty"`
+	AvatarURL         string  `json:"avatar_url"`
+	GravatarID        string  `json:"gravatar_id,omitempty"`
+	URL               string  `json:"url"`
+	HTMLURL           string  `json:"html_url,omitempty"`
+	FollowersURL      string  `json:"followers_url,omitempty"`
+	FollowingURL      string  `json:"following_url,omitempty"`
+	GistsURL          string  `json:"gists_url,omitempty"`
+	StarredURL        string  `json:"starred_url,omitempty"`
+	SubscriptionsURL  string  `json:"subscriptions_url,omitempty"`
+	OrganizationsURL  string  `json:"organizations_url,omitempty"`
+	ReposURL          string  `json:"repos_url"`
+	EventsURL         string  `json:"events_url"`
+	ReceivedEventsURL string  `json:"received_events_url,omitempty"`
+	Type              string  `json:"type,omitempty"`
+	SiteAdmin         bool    `json:"site_admin,omitempty"`
+	HooksURL          string  `json:"hooks_url,omitempty"`
+	IssuesURL         string  `json:"issues_url,omitempty"`
+	MembersURL        string  `json:"members_url,omitempty"`
+	PublicMembersURL  string  `json:"public_members_url,omitempty"`
+	Description       string  `json:"description,omitempty"`
+}
+
+// Asset contains GitHub's asset information
+type Asset struct {
+	URL                string    `json:"url"`
+	BrowserDownloadURL string    `json:"browser_download_url"`
+	ID                 int64     `json:"id"`
+	NodeID             string    `json:"node_id"`
+	Name               string    `json:"name"`
+	Label              string    `json:"label"`
+	State              string    `json:"state"`
+	ContentType        string    `json:"content_type"`
+	Size               int64     `json:"size"`
+	DownloadCount      int64     `json:"download_count"`
+	CreatedAt          Timestamp `json:"created_at"`
+	UpdatedAt          Timestamp `json:"updated_at"`
+	Uploader           User      `json:"uploader"`
+}
+
+// BranchProtectionRule contains GitHub's branch protection rule settings
+type BranchProtectionRule struct {
+	ID                                       int64     `json:"id"`
+	RepositoryID                             int64     `json:"repository_id"`
+	Name                                     string    `json:"name"`
+	CreatedAt                                Timestamp `json:"created_at"`
+	UpdatedAt                                Timestamp `json:"updated_at"`
+	PullRequestReviewsEnforcementLevel       string    `json:"pull_request_reviews_enforcement_level"`
+	RequiredApprovingReviewCount             int64     `json:"required_approving_review_count"`
+	DismissStaleReviewsOnPush                bool      `json:"dismiss_stale_reviews_on_push"`
+	RequireCodeOwnerReview                   bool      `json:"require_code_owner_review"`
+	AuthorizedDismissalActorsOnly            bool      `json:"authorized_dismissal_actors_only"`
+	IgnoreApprovalsFromContributors          bool      `json:"ignore_approvals_from_contributors"`
+	RequireLastPushApproval                  bool      `json:"require_last_push_approval"`
+	RequiredStatusChecks                     []string  `json:"required_status_checks"`
+	RequiredStatusChecksEnforcementLevel     string    `json:"required_status_checks_enforcement_level"`
+	StrictRequiredStatusChecksPolicy         bool      `json:"strict_required_status_checks_policy"`
+	SignatureRequirementEnforcementLevel     string    `json:"signature_requirement_enforcement_level"`
+	LinearHistoryRequirementEnforcementLevel string    `json:"linear_history_requirement_enforcement_level"`
+	LockBranchEnforcementLevel               string    `json:"lock_branch_enforcement_level"`
+	AdminEnforced                            bool      `json:"admin_enforced"`
+	AllowForcePushesEnforcementLevel         string    `json:"allow_force_pushes_enforcement_level"`
+	AllowDeletionsEnforcementLevel           string    `json:"allow_deletions_enforcement_level"`
+	MergeQueueEnforcementLevel               string    `json:"merge_queue_enforcement_level"`
+	RequiredDeploymentsEnforcementLevel      string    `json:"required_deployments_enforcement_level"`
+	RequiredConversationResolutionLevel      string    `json:"required_conversation_resolution_level"`
+	AuthorizedActorsOnly                     bool      `json:"authorized_actors_only"`
+	AuthorizedActorNames                     []string  `json:"authorized_actor_names"`
+	LockAllowsForkSync                       bool      `json:"lock_allows_fork_sync"`
+	CreateProtected                          bool      `json:"create_protected"`
+}
+
+// CheckPullRequest contains the pull request a check suite or check run is associated with, limited to its
+// number and branches
+type CheckPullRequest struct {
+	URL    string              `json:"url"`
+	ID     int64               `json:"id"`
+	Number int64               `json:"number"`
+	Head   CheckPullRequestRef `json:"head"`
+	Base   CheckPullRequestRef `json:"base"`
+}
+
+// CheckPullRequestRef contains the head or base branch of a CheckPullRequest
+type CheckPullRequestRef struct {
+	Ref  string `json:"ref"`
+	SHA  string `json:"sha"`
+	Repo struct {
+		ID   int64  `json:"id"`
+		URL  string `json:"url"`
+		Name string `json:"name"`
+	} `json:"repo"`
+}
+
+// CheckRunRequestedAction contains the action requested by the user on a check run, sent with the
+// requested_action action
+type CheckRunRequestedAction struct {
+	// Identifier is the identifier given by the app to the action when creating the check run
+	Identifier string `json:"identifier"`
+}
+
+// Commit contains GitHub's commit information of the push event
+type Commit struct {
+	Sha       string       `json:"sha"`
+	ID        string       `json:"id"`
+	NodeID    string       `json:"node_id"`
+	TreeID    string       `json:"tree_id"`
+	Distinct  bool         `json:"distinct"`
+	Message   string       `json:"message"`
+	Timestamp Timestamp    `json:"timestamp"`
+	URL       string       `json:"url"`
+	Author    CommitAuthor `json:"author"`
+	Committer CommitAuthor `json:"committer"`
+	Added     []string     `json:"added"`
+	Removed   []string     `json:"removed"`
+	Modified  []string     `json:"modified"`
+}
+
+// CommitAuthor contains the git author or committer of a commit
+type CommitAuthor struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// ContainerMetadata contains the tag, labels and manifest of a container package version
+type ContainerMetadata struct {
+	Tag struct {
+		Name   string `json:"name"`
+		Digest string `json:"digest"`
+	} `json:"tag"`
+	Labels struct {
+		Description string            `json:"description"`
+		Source      string            `json:"source"`
+		Revision    string            `json:"revision"`
+		ImageURL    string            `json:"image_url"`
+		Licenses    string            `json:"licenses"`
+		AllLabels   map[string]string `json:"all_labels"`
+	} `json:"labels"`
+	Manifest struct {
+		Digest    string `json:"digest"`
+		MediaType string `json:"media_type"`
+		URI       string `json:"uri"`
+		Size      int64  `json:"size"`
+		Config    struct {
+			Digest    string `json:"digest"`
+			MediaType string `json:"media_type"`
+			Size      int64  `json:"size"`
+		} `json:"config"`
+		Layers []struct {
+			Digest    string `json:"digest"`
+			MediaType string `json:"media_type"`
+			Size      int64  `json:"size"`
+		} `json:"layers"`
+	} `json:"manifest"`
+}
+
+// CustomProperty contains GitHub's custom property definition
+type CustomProperty struct {
+	PropertyName     string        `json:"property_name"`
+	URL              string        `json:"url,omitempty"`
+	SourceType       string        `json:"source_type,omitempty"`
+	ValueType        string        `json:"value_type"`
+	Required         bool          `json:"required"`
+	DefaultValue     PropertyValue `json:"default_value"`
+	Description      *string       `json:"description"`
+	AllowedValues    []string      `json:"allowed_values"`
+	ValuesEditableBy *string       `json:"values_editable_by"`
+}
+
+// CustomPropertyValue contains the value of a custom property set on a repository
+type CustomPropertyValue struct {
+	PropertyName string        `json:"property_name"`
+	Value        PropertyValue `json:"value"`
+}
+
+// Enterprise is an enterprise on GitHub. This property is included when the event occurs in an enterprise or for
+// an enterprise.
+type Enterprise struct {
+	ID          int64     `json:"id"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	NodeID      string    `json:"node_id"`
+	AvatarURL   string    `json:"avatar_url"`
+	Description string    `json:"description"`
+	WebsiteURL  string    `json:"website_url"`
+	HTMLURL     string    `json:"html_url"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// Installation is the GitHub App installation. This property is included when the event is configured for and sent
+// to a GitHub App.
+type Installation struct {
+	// The ID of the installation.
+	ID     int64  `json:"id"`
+	NodeID string `json:"node_id"`
+}
+
+// Issue contains GitHub's issue information
+type Issue struct {
+	URL                      string                    `json:"url"`
+	RepositoryURL            string                    `json:"repository_url"`
+	LabelsURL                string                    `json:"labels_url"`
+	CommentsURL              string                    `json:"comments_url"`
+	EventsURL                string                    `json:"events_url"`
+	HTMLURL                  string                    `json:"html_url"`
+	ID                       int64                     `json:"id"`
+	NodeID                   string                    `json:"node_id"`
+	Number                   int64                     `json:"number"`
+	Title                    string                    `json:"title"`
+	User                     User                      `json:"user"`
+	Labels                   []Label                   `json:"labels"`
+	State                    string                    `json:"state"`
+	StateReason              *string                   `json:"state_reason"`
+	Locked                   bool                      `json:"locked"`
+	Assignee                 *User                     `json:"assignee"`
+	Assignees                []*User                   `json:"assignees"`
+	Milestone                *Milestone                `json:"milestone"`
+	Comments                 int64                     `json:"comments"`
+	CreatedAt                Timestamp                 `json:"created_at"`
+	UpdatedAt                Timestamp                 `json:"updated_at"`
+	ClosedAt                 *Timestamp                `json:"closed_at"`
+	AuthorAssociation        string                    `json:"author_association"`
+	PullRequest              *IssuePullRequest         `json:"pull_request,omitempty"`
+	Body                     string                    `json:"body"`
+	Reactions                Reactions                 `json:"reactions,omitempty"`
+	Type                     *IssueType                `json:"type"`
+	SubIssuesSummary         *SubIssuesSummary         `json:"sub_issues_summary,omitempty"`
+	IssueDependenciesSummary *IssueDependenciesSummary `json:"issue_dependencies_summary,omitempty"`
+}
+
+// IssueDependenciesSummary contains the number of issues blocking or blocked by an issue
+type IssueDependenciesSummary struct {
+	BlockedBy      int64 `json:"blocked_by"`
+	TotalBlockedBy int64 `json:"total_blocked_by"`
+	Blocking       int64 `json:"blocking"`
+	TotalBlocking  int64 `json:"total_blocking"`
+}
+
+// IssuePullRequest contains the links to the pull request an issue stands for
+type IssuePullRequest struct {
+	URL      string `json:"url"`
+	HTMLURL  string `json:"html_url"`
+	DiffURL  string `json:"diff_url"`
+	PatchURL string `json:"patch_url"`
+}
+
+// IssueType contains the type of an issue defined by its organization
+type IssueType struct {
+	ID          int64     `json:"id"`
+	NodeID      string    `json:"node_id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	Color       *string   `json:"color"`
+	IsEnabled   bool      `json:"is_enabled"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// Label contains Issue's Label information
+type Label struct {
+	ID          int64  `json:"id"`
+	NodeID      string `json:"node_id"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Default     bool   `json:"default"`
+}
+
+// License contains the license information of the payloads
+type License struct {
+	Key    string  `json:"key"`
+	Name   string  `json:"name"`
+	SpdxID string  `json:"spdx_id"`
+	URL    *string `json:"url"`
+	NodeID string  `json:"node_id"`
+}
+
+// Link contains a link of the _links objects
+type Link struct {
+	Href string `json:"href"`
+}
+
+// MarketplacePurchase contains GitHub's Marketplace purchase information
+type MarketplacePurchase struct {
+	Account struct {
+		Type                     string  `json:"type"`
+		ID                       int64   `json:"id"`
+		NodeID                   string  `json:"node_id"`
+		Login                    string  `json:"login"`
+		OrganizationBillingEmail *string `json:"organization_billing_email"`
+	} `json:"account"`
+	BillingCycle    string     `json:"billing_cycle"`
+	UnitCount       int64      `json:"unit_count"`
+	OnFreeTrial     bool       `json:"on_free_trial"`
+	FreeTrialEndsOn *Timestamp `json:"free_trial_ends_on"`
+	NextBillingDate *Timestamp `json:"next_billing_date"`
+	Plan            struct {
+		ID                  int64    `json:"id"`
+		Name                string   `json:"name"`
+		Description         string   `json:"description"`
+		MonthlyPriceInCents int64    `json:"monthly_price_in_cents"`
+		YearlyPriceInCents  int64    `json:"yearly_price_in_cents"`
+		PriceModel          string   `json:"price_model"`
+		HasFreeTrial        bool     `json:"has_free_trial"`
+		UnitName            *string  `json:"unit_name"`
+		Bullets             []string `json:"bullets"`
+	} `json:"plan"`
+}
+
+// Milestone contains GitHub's milestone information
+type Milestone struct {
+	URL          string    `json:"url"`
+	HTMLURL      string    `json:"html_url"`
+	LabelsURL    string    `json:"labels_url"`
+	ID           int64     `json:"id"`
+	NodeID       string    `json:"node_id"`
+	Number       int64     `json:"number"`
+	State        string    `json:"state"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	Creator      User      `json:"creator"`
+	OpenIssues   int64     `json:"open_issues"`
+	ClosedIssues int64     `json:"closed_issues"`
+	CreatedAt    Timestamp `json:"created_at"`
+	UpdatedAt    Timestamp `json:"updated_at"`
+	ClosedAt     Timestamp `json:"closed_at"`
+	DueOn        Timestamp `json:"due_on"`
+}
+
+// NpmMetadata contains the package.json information of an npm package version
+type NpmMetadata struct {
+	Name                 string                 `json:"name"`
+	Version              string                 `json:"version"`
+	NpmUser              string                 `json:"npm_user"`
+	Author               interface{}            `json:"author"`
+	Bugs                 interface{}            `json:"bugs"`
+	Dependencies         map[string]string      `json:"dependencies"`
+	DevDependencies      map[string]string      `json:"dev_dependencies"`
+	PeerDependencies     map[string]string      `json:"peer_dependencies"`
+	OptionalDependencies map[string]string      `json:"optional_dependencies"`
+	Description          string                 `json:"description"`
+	Dist                 map[string]interface{} `json:"dist"`
+	GitHead              string                 `json:"git_head"`
+	Homepage             string                 `json:"homepage"`
+	License              string                 `json:"license"`
+	Main                 string                 `json:"main"`
+	Repository           interface{}            `json:"repository"`
+	Scripts              map[string]interface{} `json:"scripts"`
+	ID                   string                 `json:"id"`
+	NodeVersion          string                 `json:"node_version"`
+	NpmVersion           string                 `json:"npm_version"`
+	HasShrinkwrap        bool                   `json:"has_shrinkwrap"`
+	Maintainers          []interface{}          `json:"maintainers"`
+	Contributors         []interface{}          `json:"contributors"`
+	Engines              map[string]string      `json:"engines"`
+	Keywords             []string               `json:"keywords"`
+	Files                []string               `json:"files"`
+	Bin                  map[string]interface{} `json:"bin"`
+	Man                  map[string]interface{} `json:"man"`
+	Directories          map[string]interface{} `json:"directories"`
+	OS                   []string               `json:"os"`
+	CPU                  []string               `json:"cpu"`
+	Readme               string                 `json:"readme"`
+	InstallationCommand  string                 `json:"installation_command"`
+	ReleaseID            int64                  `json:"release_id"`
+	CommitOID            string                 `json:"commit_oid"`
+	PublishedViaActions  bool                   `json:"published_via_actions"`
+	DeletedByID          int64                  `json:"deleted_by_id"`
+}
+
+// Organization contains the organization information of the payloads
+type Organization struct {
+	Login            string `json:"login"`
+	ID               int64  `json:"id"`
+	NodeID           string `json:"node_id"`
+	URL              string `json:"url"`
+	HTMLURL          string `json:"html_url,omitempty"`
+	ReposURL         string `json:"repos_url"`
+	EventsURL        string `json:"events_url"`
+	HooksURL         string `json:"hooks_url"`
+	IssuesURL        string `json:"issues_url"`
+	MembersURL       string `json:"members_url"`
+	PublicMembersURL string `json:"public_members_url"`
+	AvatarURL        string `json:"avatar_url"`
+	Description      string `json:"description"`
+}
+
+// Package contains GitHub's package information
+type Package struct {
+	ID             int64           `json:"id"`
+	Name           string          `json:"name"`
+	Namespace      string          `json:"namespace"`
+	Description    *string         `json:"description"`
+	Ecosystem      string          `json:"ecosystem"`
+	PackageType    string          `json:"package_type"`
+	HTMLURL        string          `json:"html_url"`
+	CreatedAt      Timestamp       `json:"created_at"`
+	UpdatedAt      Timestamp       `json:"updated_at"`
+	Owner          User            `json:"owner"`
+	PackageVersion *PackageVersion `json:"package_version"`
+	Registry       *struct {
+		AboutURL string `json:"about_url"`
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		URL      string `json:"url"`
+		Vendor   string `json:"vendor"`
+	} `json:"registry"`
+}
+
+// PackageFile contains the information of a file belonging to a package version
+type PackageFile struct {
+	DownloadURL string    `json:"download_url"`
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	SHA256      *string   `json:"sha256"`
+	SHA1        *string   `json:"sha1"`
+	MD5         *string   `json:"md5"`
+	ContentType string    `json:"content_type"`
+	State       *string   `json:"state"`
+	Size        int64     `json:"size"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+}
+
+// PackageVersion contains GitHub's package version information
+type PackageVersion struct {
+	ID          int64       `json:"id"`
+	Version     string      `json:"version"`
+	Summary     string      `json:"summary"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Body        interface{} `json:"body"`
+	BodyHTML    string      `json:"body_html"`
+	Release     *struct {
+		URL             string    `json:"url"`
+		HTMLURL         string    `json:"html_url"`
+		ID              int64     `json:"id"`
+		TagName         string    `json:"tag_name"`
+		TargetCommitish string    `json:"target_commitish"`
+		Name            *string   `json:"name"`
+		Draft           bool      `json:"draft"`
+		Author          User      `json:"author"`
+		Prerelease      bool      `json:"prerelease"`
+		CreatedAt       Timestamp `json:"created_at"`
+		PublishedAt     Timestamp `json:"published_at"`
+	} `json:"release,omitempty"`
+	Manifest            string             `json:"manifest"`
+	HTMLURL             string             `json:"html_url"`
+	TagName             string             `json:"tag_name"`
+	TargetCommitish     string             `json:"target_commitish"`
+	TargetOID           string             `json:"target_oid"`
+	Draft               bool               `json:"draft"`
+	Prerelease          bool               `json:"prerelease"`
+	CreatedAt           Timestamp          `json:"created_at"`
+	UpdatedAt           Timestamp          `json:"updated_at"`
+	Metadata            []interface{}      `json:"metadata"`
+	ContainerMetadata   *ContainerMetadata `json:"container_metadata,omitempty"`
+	NpmMetadata         *NpmMetadata       `json:"npm_metadata,omitempty"`
+	PackageFiles        []PackageFile      `json:"package_files"`
+	PackageURL          string             `json:"package_url"`
+	Author              *User              `json:"author"`
+	SourceURL           string             `json:"source_url"`
+	InstallationCommand string             `json:"installation_command"`
+}
+
+// Parent contains GitHub's parent information
+type Parent struct {
+	URL string `json:"url"`
+	Sha string `json:"sha"`
+}
+
+// PersonalAccessTokenPermissions contains the organization, repository and other permissions of a
+// fine-grained personal access token, keyed by permission name with the access level as value
+type PersonalAccessTokenPermissions struct {
+	Organization map[string]string `json:"organization,omitempty"`
+	Repository   map[string]string `json:"repository,omitempty"`
+	Other        map[string]string `json:"other,omitempty"`
+}
+
+// ProjectsV2FieldValueChange contains the field value change of an edited projects_v2_item; From and To
+// hold the raw values whose shape depends on FieldType, use the accessor matching it to decode them
+type ProjectsV2FieldValueChange struct {
+	FieldNodeID   string          `json:"field_node_id"`
+	FieldType     string          `json:"field_type"`
+	FieldName     string          `json:"field_name"`
+	ProjectNumber int64           `json:"project_number"`
+	From          json.RawMessage `json:"from,omitempty"`
+	To            json.RawMessage `json:"to,omitempty"`
+}
+
+// PullRequest contains GitHub's pull request information
+type PullRequest struct {
+	URL                 string            `json:"url"`
+	ID                  int64             `json:"id"`
+	NodeID              string            `json:"node_id"`
+	HTMLURL             string            `json:"html_url"`
+	DiffURL             string            `json:"diff_url"`
+	PatchURL            string            `json:"patch_url"`
+	IssueURL            string            `json:"issue_url"`
+	Number              int64             `json:"number"`
+	State               string            `json:"state"`
+	Locked              bool              `json:"locked"`
+	Title               string            `json:"title"`
+	User                User              `json:"user"`
+	Body                string            `json:"body"`
+	CreatedAt           Timestamp         `json:"created_at"`
+	UpdatedAt           Timestamp         `json:"updated_at"`
+	ClosedAt            *Timestamp        `json:"closed_at"`
+	MergedAt            *Timestamp        `json:"merged_at"`
+	MergeCommitSha      *string           `json:"merge_commit_sha"`
+	Assignee            *User             `json:"assignee"`
+	Assignees           []*User           `json:"assignees"`
+	Milestone           *Milestone        `json:"milestone"`
+	Draft               bool              `json:"draft"`
+	CommitsURL          string            `json:"commits_url"`
+	ReviewCommentsURL   string            `json:"review_comments_url"`
+	ReviewCommentURL    string            `json:"review_comment_url"`
+	CommentsURL         string            `json:"comments_url"`
+	StatusesURL         string            `json:"statuses_url"`
+	RequestedReviewers  []User            `json:"requested_reviewers,omitempty"`
+	Labels              []Label           `json:"labels"`
+	Head                PullRequestBranch `json:"head"`
+	Base                PullRequestBranch `json:"base"`
+	Links               PullRequestLinks  `json:"_links"`
+	AuthorAssociation   string            `json:"author_association"`
+	Merged              bool              `json:"merged"`
+	Mergeable           *bool             `json:"mergeable"`
+	MergeableState      string            `json:"mergeable_state"`
+	MergedBy            *User             `json:"merged_by"`
+	Comments            int64             `json:"comments"`
+	ReviewComments      int64             `json:"review_comments"`
+	MaintainerCanModify bool              `json:"maintainer_can_modify"`
+	Commits             int64             `json:"commits"`
+	Additions           int64             `json:"additions"`
+	Deletions           int64             `json:"deletions"`
+	ChangedFiles        int64             `json:"changed_files"`
+}
+
+// PullRequestBranch contains the head or base branch of a pull request
+type PullRequestBranch struct {
+	Label string     `json:"label"`
+	Ref   string     `json:"ref"`
+	Sha   string     `json:"sha"`
+	User  User       `json:"user"`
+	Repo  Repository `json:"repo"`
+}
+
+// PullRequestLinks contains the API and web links of a pull request
+type PullRequestLinks struct {
+	Self           Link `json:"self"`
+	HTML           Link `json:"html"`
+	Issue          Link `json:"issue"`
+	Comments       Link `json:"comments"`
+	ReviewComments Link `json:"review_comments"`
+	ReviewComment  Link `json:"review_comment"`
+	Commits        Link `json:"commits"`
+	Statuses       Link `json:"statuses"`
+}
+
+// Reactions contains the reaction counts of an issue, a comment or a discussion
+type Reactions struct {
+	URL        string `json:"url"`
+	TotalCount int64  `json:"total_count"`
+	PlusOne    int64  `json:"+1"`
+	MinusOne   int64  `json:"-1"`
+	Laugh      int64  `json:"laugh"`
+	Hooray     int64  `json:"hooray"`
+	Confused   int64  `json:"confused"`
+	Heart      int64  `json:"heart"`
+	Rocket     int64  `json:"rocket"`
+	Eyes       int64  `json:"eyes"`
+}
+
+// Repository is a git repository
+type Repository struct {
+	ID                       int64                `json:"id"`
+	NodeID                   string               `json:"node_id"`
+	Name                     string               `json:"name"`
+	FullName                 string               `json:"full_name"`
+	Private                  bool                 `json:"private"`
+	Owner                    User                 `json:"owner"`
+	HTMLURL                  string               `json:"html_url"`
+	Description              string               `json:"description"`
+	Fork                     bool                 `json:"fork"`
+	URL                      string               `json:"url"`
+	ForksURL                 string               `json:"forks_url"`
+	KeysURL                  string               `json:"keys_url"`
+	CollaboratorsURL         string               `json:"collaborators_url"`
+	TeamsURL                 string               `json:"teams_url"`
+	HooksURL                 string               `json:"hooks_url"`
+	IssueEventsURL           string               `json:"issue_events_url"`
+	EventsURL                string               `json:"events_url"`
+	AssigneesURL             string               `json:"assignees_url"`
+	BranchesURL              string               `json:"branches_url"`
+	TagsURL                  string               `json:"tags_url"`
+	BlobsURL                 string               `json:"blobs_url"`
+	GitTagsURL               string               `json:"git_tags_url"`
+	GitRefsURL               string               `json:"git_refs_url"`
+	TreesURL                 string               `json:"trees_url"`
+	StatusesURL              string               `json:"statuses_url"`
+	LanguagesURL             string               `json:"languages_url"`
+	StargazersURL            string               `json:"stargazers_url"`
+	ContributorsURL          string               `json:"contributors_url"`
+	SubscribersURL           string               `json:"subscribers_url"`
+	SubscriptionURL          string               `json:"subscription_url"`
+	CommitsURL               string               `json:"commits_url"`
+	GitCommitsURL            string               `json:"git_commits_url"`
+	CommentsURL              string               `json:"comments_url"`
+	IssueCommentURL          string               `json:"issue_comment_url"`
+	ContentsURL              string               `json:"contents_url"`
+	CompareURL               string               `json:"compare_url"`
+	MergesURL                string               `json:"merges_url"`
+	ArchiveURL               string               `json:"archive_url"`
+	DownloadsURL             string               `json:"downloads_url"`
+	IssuesURL                string               `json:"issues_url"`
+	PullsURL                 string               `json:"pulls_url"`
+	MilestonesURL            string               `json:"milestones_url"`
+	NotificationsURL         string               `json:"notifications_url"`
+	LabelsURL                string               `json:"labels_url"`
+	ReleasesURL              string               `json:"releases_url"`
+	DeploymentsURL           string               `json:"deployments_url"`
+	CreatedAt                Timestamp            `json:"created_at"`
+	UpdatedAt                Timestamp            `json:"updated_at"`
+	PushedAt                 Timestamp            `json:"pushed_at"`
+	GitURL                   string               `json:"git_url"`
+	SSHURL                   string               `json:"ssh_url"`
+	CloneURL                 string               `json:"clone_url"`
+	SvnURL                   string               `json:"svn_url"`
+	Homepage                 *string              `json:"homepage"`
+	Size                     int64                `json:"size"`
+	StargazersCount          int64                `json:"stargazers_count"`
+	WatchersCount            int64                `json:"watchers_count"`
+	Language                 *string              `json:"language"`
+	HasIssues                bool                 `json:"has_issues"`
+	HasProjects              bool                 `json:"has_projects"`
+	HasDownloads             bool                 `json:"has_downloads"`
+	HasWiki                  bool                 `json:"has_wiki"`
+	HasPages                 bool                 `json:"has_pages"`
+	HasDiscussions           bool                 `json:"has_discussions,omitempty"`
+	ForksCount               int64                `json:"forks_count"`
+	MirrorURL                *string              `json:"mirror_url"`
+	Archived                 bool                 `json:"archived"`
+	Disabled                 bool                 `json:"disabled"`
+	OpenIssuesCount          int64                `json:"open_issues_count"`
+	License                  *License             `json:"license"`
+	AllowForking             bool                 `json:"allow_forking,omitempty"`
+	IsTemplate               bool                 `json:"is_template,omitempty"`
+	WebCommitSignoffRequired bool                 `json:"web_commit_signoff_required,omitempty"`
+	Topics                   []string             `json:"topics,omitempty"`
+	Visibility               string               `json:"visibility,omitempty"`
+	Forks                    int64                `json:"forks"`
+	OpenIssues               int64                `json:"open_issues"`
+	Watchers                 int64                `json:"watchers"`
+	Stargazers               int64                `json:"stargazers,omitempty"`
+	DefaultBranch            string               `json:"default_branch"`
+	MasterBranch             string               `json:"master_branch,omitempty"`
+	Organization             string               `json:"organization,omitempty"`
+	Public                   bool                 `json:"public,omitempty"`
+	SecurityAndAnalysis      *SecurityAndAnalysis `json:"security_and_analysis,omitempty"`
+}
+
+// RepositoryRuleset contains GitHub's repository ruleset information
+type RepositoryRuleset struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Target       string `json:"target"`
+	SourceType   string `json:"source_type"`
+	Source       string `json:"source"`
+	Enforcement  string `json:"enforcement"`
+	BypassActors []struct {
+		ActorID    *int64 `json:"actor_id"`
+		ActorType  string `json:"actor_type"`
+		BypassMode string `json:"bypass_mode"`
+	} `json:"bypass_actors"`
+	CurrentUserCanBypass string `json:"current_user_can_bypass,omitempty"`
+	NodeID               string `json:"node_id"`
+	Links                struct {
+		Self Link `json:"self"`
+		HTML Link `json:"html"`
+	} `json:"_links"`
+	Conditions *RulesetConditions `json:"conditions"`
+	Rules      []RulesetRule      `json:"rules"`
+	CreatedAt  Timestamp          `json:"created_at"`
+	UpdatedAt  Timestamp          `json:"updated_at"`
+}
+
+// RulesetConditions contains the ref, repository and organization conditions of a ruleset
+type RulesetConditions struct {
+	RefName *struct {
+		Include []string `json:"include"`
+		Exclude []string `json:"exclude"`
+	} `json:"ref_name,omitempty"`
+	RepositoryName *struct {
+		Include   []string `json:"include"`
+		Exclude   []string `json:"exclude"`
+		Protected bool     `json:"protected"`
+	} `json:"repository_name,omitempty"`
+	RepositoryID *struct {
+		RepositoryIDs []int64 `json:"repository_ids"`
+	} `json:"repository_id,omitempty"`
+	RepositoryProperty *struct {
+		Include []struct {
+			Name           string   `json:"name"`
+			PropertyValues []string `json:"property_values"`
+			Source         string   `json:"source,omitempty"`
+		} `json:"include"`
+		Exclude []struct {
+			Name           string   `json:"name"`
+			PropertyValues []string `json:"property_values"`
+			Source         string   `json:"source,omitempty"`
+		} `json:"exclude"`
+	} `json:"repository_property,omitempty"`
+}
+
+// RulesetRule contains a rule of a ruleset; Parameters depend on Type, see the RulesetRule*Parameters types
+type RulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// SecretScanningAlert contains GitHub's secret scanning alert information
+type SecretScanningAlert struct {
+	Number                                     int64      `json:"number"`
+	CreatedAt                                  Timestamp  `json:"created_at"`
+	UpdatedAt                                  *Timestamp `json:"updated_at"`
+	URL                                        string     `json:"url"`
+	HTMLURL                                    string     `json:"html_url"`
+	LocationsURL                               string     `json:"locations_url"`
+	State                                      string     `json:"state"`
+	Resolution                                 *string    `json:"resolution"`
+	ResolvedAt                                 *Timestamp `json:"resolved_at"`
+	ResolvedBy                                 *User      `json:"resolved_by"`
+	ResolutionComment                          *string    `json:"resolution_comment"`
+	SecretType                                 string     `json:"secret_type"`
+	SecretTypeDisplayName                      string     `json:"secret_type_display_name"`
+	Validity                                   string     `json:"validity"`
+	PushProtectionBypassed                     *bool      `json:"push_protection_bypassed"`
+	PushProtectionBypassedBy                   *User      `json:"push_protection_bypassed_by"`
+	PushProtectionBypassedAt                   *Timestamp `json:"push_protection_bypassed_at"`
+	PushProtectionBypassRequestReviewer        *User      `json:"push_protection_bypass_request_reviewer"`
+	PushProtectionBypassRequestReviewerComment *string    `json:"push_protection_bypass_request_reviewer_comment"`
+	PushProtectionBypassRequestComment         *string    `json:"push_protection_bypass_request_comment"`
+	PushProtectionBypassRequestHTMLURL         *string    `json:"push_protection_bypass_request_html_url"`
+	PubliclyLeaked                             *bool      `json:"publicly_leaked"`
+	MultiRepo                                  *bool      `json:"multi_repo"`
+	IsBase64Encoded                            *bool      `json:"is_base64_encoded"`
+	AssignedTo                                 *User      `json:"assigned_to"`
+}
+
+// SecurityAndAnalysis contains the state of the security features of a repository
+type SecurityAndAnalysis struct {
+	AdvancedSecurity                  *SecurityFeature `json:"advanced_security,omitempty"`
+	CodeSecurity                      *SecurityFeature `json:"code_security,omitempty"`
+	DependabotSecurityUpdates         *SecurityFeature `json:"dependabot_security_updates,omitempty"`
+	SecretScanning                    *SecurityFeature `json:"secret_scanning,omitempty"`
+	SecretScanningPushProtection      *SecurityFeature `json:"secret_scanning_push_protection,omitempty"`
+	SecretScanningNonProviderPatterns *SecurityFeature `json:"secret_scanning_non_provider_patterns,omitempty"`
+	SecretScanningAIDetection         *SecurityFeature `json:"secret_scanning_ai_detection,omitempty"`
+	SecretScanningValidityChecks      *SecurityFeature `json:"secret_scanning_validity_checks,omitempty"`
+}
+
+// SecurityFeature contains the status, enabled or disabled, of a repository security feature
+type SecurityFeature struct {
+	Status string `json:"status"`
+}
+
+// SponsorshipTier contains GitHub's sponsorship tier information
+type SponsorshipTier struct {
+	NodeID                string    `json:"node_id"`
+	CreatedAt             Timestamp `json:"created_at"`
+	Description           string    `json:"description"`
+	MonthlyPriceInCents   int64     `json:"monthly_price_in_cents"`
+	MonthlyPriceInDollars int64     `json:"monthly_price_in_dollars"`
+	Name                  string    `json:"name"`
+	IsOneTime             bool      `json:"is_one_time"`
+	IsCustomAmount        bool      `json:"is_custom_amount"`
+}
+
+// SubIssuesSummary contains the progress of the sub-issues of an issue
+type SubIssuesSummary struct {
+	Total            int64 `json:"total"`
+	Completed        int64 `json:"completed"`
+	PercentCompleted int64 `json:"percent_completed"`
+}
+
+// Team contains GitHub's Team information
+type Team struct {
+	Name            string `json:"name"`
+	ID              int64  `json:"id"`
+	NodeID          string `json:"node_id"`
+	Slug            string `json:"slug"`
+	Permission      string `json:"permission"`
+	URL             string `json:"url"`
+	MembersURL      string `json:"members_url"`
+	RepositoriesURL string `json:"repositories_url"`
+	Parent          *Team  `json:"parent,omitempty"`
+}
+
+// User contains the user information of the payloads
+type User struct {
+	Login             string  `json:"login"`
+	ID                int64   `json:"id"`
+	NodeID            string  `json:"node_id"`
+	Name              string  `json:"name,omitempty"`
+	Email             *string `json:"email,omitempty"`
+	AvatarURL         string  `json:"avatar_url"`
+	GravatarID        string  `json:"gravatar_id"`
+	URL               string  `json:"url"`
+	HTMLURL           string  `json:"html_url"`
+	FollowersURL      string  `json:"followers_url"`
+	FollowingURL      string  `json:"following_url"`
+	GistsURL          string  `json:"gists_url"`
+	StarredURL        string  `json:"starred_url"`
+	SubscriptionsURL  string  `json:"subscriptions_url"`
+	OrganizationsURL  string  `json:"organizations_url"`
+	ReposURL          string  `json:"repos_url"`
+	EventsURL         string  `json:"events_url"`
+	ReceivedEventsURL string  `json:"received_events_url"`
+	Type              string  `json:"type"`
+	SiteAdmin         bool    `json:"site_admin"`
+}
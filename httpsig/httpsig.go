@@ -0,0 +1,414 @@
+package httpsig
+
+// this package verifies requests signed according to the HTTP Signatures draft
+// https://datatracker.ietf.org/doc/html/draft-cavage-http-signatures-12
+// along with the Digest header covering their body
+// https://datatracker.ietf.org/doc/html/rfc3230
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// verification errors
+var (
+	ErrMissingSignatureHeader      = errors.New("missing Signature Header")
+	ErrMalformedSignatureHeader    = errors.New("malformed Signature Header")
+	ErrUnsupportedAlgorithm        = errors.New("unsupported signature algorithm")
+	ErrMissingSignedHeader         = errors.New("signed header missing from the request")
+	ErrRequiredHeaderNotSigned     = errors.New("required header not covered by the signature")
+	ErrUnknownKey                  = errors.New("unknown signature keyId")
+	ErrInvalidKey                  = errors.New("key type does not match the signature algorithm")
+	ErrSignatureVerificationFailed = errors.New("signature verification failed")
+	ErrSignatureExpired            = errors.New("signature expired")
+	ErrMissingDigestHeader         = errors.New("missing Digest Header")
+	ErrUnsupportedDigest           = errors.New("unsupported Digest algorithm")
+	ErrDigestVerificationFailed    = errors.New("Digest verification failed")
+	ErrInvalidDateHeader           = errors.New("invalid Date Header")
+	ErrDateVerificationFailed      = errors.New("Date outside of the allowed clock skew")
+)
+
+// Supported signature algorithms
+const (
+	AlgorithmHmacSha256 = "hmac-sha256"
+	AlgorithmEd25519    = "ed25519"
+)
+
+// Pseudo headers covering the request line and the signature parameters
+const (
+	// RequestTarget covers the method and path of the request
+	RequestTarget = "(request-target)"
+	// Created covers the created parameter of the signature
+	Created = "(created)"
+	// Expires covers the expires parameter of the signature
+	Expires = "(expires)"
+)
+
+// DefaultHeaders are the headers signed and required when none are specified
+var DefaultHeaders = []string{RequestTarget, "date", "digest"}
+
+// KeyGetter resolves the keyId of a signature into the key used to verify it,
+// a []byte secret for hmac-sha256 or an ed25519.PublicKey for ed25519
+type KeyGetter func(keyID string) (interface{}, error)
+
+// Signature holds the parameters of a parsed Signature Header
+type Signature struct {
+	KeyID     string
+	Algorithm string
+	Headers   []string
+	Signature []byte
+	// Created and Expires are the optional creation and expiration times, zero when absent
+	Created time.Time
+	Expires time.Time
+
+	// created and expires keep the parameters as sent for the signing string
+	created string
+	expires string
+}
+
+// Verifier checks the signature, digest and date of incoming requests
+type Verifier struct {
+	// Keys resolves the key of a signature, it is required
+	Keys KeyGetter
+	// Required lists the headers the signature must cover, DefaultHeaders when empty
+	Required []string
+	// MaxSkew is the maximum difference allowed between the Date Header and the current time,
+	// the check is skipped when zero
+	MaxSkew time.Duration
+	// Now returns the current time the Date Header and expires parameter are checked against,
+	// time.Now when nil
+	Now func() time.Time
+}
+
+// Verify checks the request signature against body, which must be the complete request body;
+// the Digest Header is verified whenever it is part of the signed headers
+func (v Verifier) Verify(r *http.Request, body []byte) error {
+	sig, err := ParseSignature(signatureHeader(r.Header))
+	if err != nil {
+		return err
+	}
+
+	required := v.Required
+	if len(required) == 0 {
+		required = DefaultHeaders
+	}
+	for _, name := range required {
+		if !sig.covers(name) {
+			return fmt.Errorf("%w: %s", ErrRequiredHeaderNotSigned, name)
+		}
+	}
+
+	if v.Keys == nil {
+		return ErrUnknownKey
+	}
+	key, err := v.Keys(sig.KeyID)
+	if err != nil || key == nil {
+		return ErrUnknownKey
+	}
+
+	signed, err := sig.SigningString(r)
+	if err != nil {
+		return err
+	}
+	if err := verify(sig.Algorithm, key, []byte(signed), sig.Signature); err != nil {
+		return err
+	}
+
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+
+	if !sig.Expires.IsZero() && now().After(sig.Expires) {
+		return ErrSignatureExpired
+	}
+
+	if sig.covers("digest") {
+		if err := VerifyDigest(r.Header.Get("Digest"), body); err != nil {
+			return err
+		}
+	}
+
+	if v.MaxSkew > 0 {
+		date, err := http.ParseTime(r.Header.Get("Date"))
+		if err != nil {
+			return ErrInvalidDateHeader
+		}
+		skew := now().Sub(date)
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew > v.MaxSkew {
+			return ErrDateVerificationFailed
+		}
+	}
+	return nil
+}
+
+// Sign adds the Digest, when covered, and Signature Headers to the request, setting the created
+// parameter when (created) is covered; it is mainly useful to test handlers and for services
+// sending signed requests themselves
+func Sign(r *http.Request, body []byte, keyID, algorithm string, key interface{}, headers ...string) error {
+	if len(headers) == 0 {
+		headers = DefaultHeaders
+	}
+	sig := Signature{Headers: headers}
+	for _, h := range headers {
+		if strings.EqualFold(h, Created) {
+			sig.created = strconv.FormatInt(time.Now().Unix(), 10)
+		}
+		if strings.EqualFold(h, "digest") {
+			sum := sha256.Sum256(body)
+			r.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sum[:]))
+		}
+		if strings.EqualFold(h, "date") && len(r.Header.Get("Date")) == 0 {
+			r.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		}
+	}
+
+	signed, err := sig.SigningString(r)
+	if err != nil {
+		return err
+	}
+
+	var signature []byte
+	switch algorithm {
+	case AlgorithmHmacSha256:
+		secret, ok := key.([]byte)
+		if !ok {
+			return ErrInvalidKey
+		}
+		mac := hmac.New(sha256.New, secret)
+		_, _ = mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case AlgorithmEd25519:
+		private, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return ErrInvalidKey
+		}
+		signature = ed25519.Sign(private, []byte(signed))
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+
+	var created string
+	if len(sig.created) > 0 {
+		created = ",created=" + sig.created
+	}
+	r.Header.Set("Signature", fmt.Sprintf(`keyId="%s",algorithm="%s"%s,headers="%s",signature="%s"`,
+		keyID, algorithm, created, strings.ToLower(strings.Join(headers, " ")), base64.StdEncoding.EncodeToString(signature)))
+	return nil
+}
+
+// ParseSignature parses the parameters of a Signature Header, or of an Authorization Header
+// using the Signature scheme; created and expires may be sent as unquoted numbers
+func ParseSignature(header string) (*Signature, error) {
+	if len(header) == 0 {
+		return nil, ErrMissingSignatureHeader
+	}
+	header = strings.TrimPrefix(header, "Signature ")
+
+	sig := &Signature{
+		// the draft defaults to the Date Header alone
+		Headers: []string{"date"},
+	}
+	for len(header) > 0 {
+		eq := strings.IndexByte(header, '=')
+		if eq < 1 || len(header) < eq+2 {
+			return nil, ErrMalformedSignatureHeader
+		}
+		name := strings.TrimSpace(header[:eq])
+		var value string
+		if header[eq+1] == '"' {
+			rest := header[eq+2:]
+			end := strings.IndexByte(rest, '"')
+			if end < 0 {
+				return nil, ErrMalformedSignatureHeader
+			}
+			value = rest[:end]
+			header = strings.TrimLeft(rest[end+1:], ", ")
+		} else {
+			// only the numeric parameters are sent unquoted
+			if name != "created" && name != "expires" {
+				return nil, ErrMalformedSignatureHeader
+			}
+			rest := header[eq+1:]
+			end := strings.IndexByte(rest, ',')
+			if end < 0 {
+				end = len(rest)
+			}
+			value = strings.TrimSpace(rest[:end])
+			header = strings.TrimLeft(rest[end:], ", ")
+		}
+
+		switch name {
+		case "keyId":
+			sig.KeyID = value
+		case "algorithm":
+			sig.Algorithm = strings.ToLower(value)
+		case "headers":
+			sig.Headers = strings.Fields(strings.ToLower(value))
+		case "signature":
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return nil, ErrMalformedSignatureHeader
+			}
+			sig.Signature = decoded
+		case "created":
+			created, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, ErrMalformedSignatureHeader
+			}
+			sig.Created, sig.created = time.Unix(created, 0), value
+		case "expires":
+			// the expiration time may carry a fraction of a second
+			expires, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, ErrMalformedSignatureHeader
+			}
+			sec := int64(expires)
+			sig.Expires, sig.expires = time.Unix(sec, int64((expires-float64(sec))*float64(time.Second))), value
+		}
+	}
+
+	if len(sig.KeyID) == 0 || len(sig.Signature) == 0 || len(sig.Headers) == 0 {
+		return nil, ErrMalformedSignatureHeader
+	}
+	return sig, nil
+}
+
+// SigningString builds the string covered by a signature over the given headers, which cannot
+// include the (created) and (expires) pseudo headers; see Signature.SigningString for those
+func SigningString(r *http.Request, headers []string) (string, error) {
+	return Signature{Headers: headers}.SigningString(r)
+}
+
+// SigningString builds the string covered by the signature, taking the (created) and (expires)
+// pseudo headers from its parameters
+func (s Signature) SigningString(r *http.Request) (string, error) {
+	lines := make([]string, 0, len(s.Headers))
+	for _, h := range s.Headers {
+		h = strings.ToLower(h)
+		switch h {
+		case RequestTarget:
+			lines = append(lines, fmt.Sprintf("%s: %s %s", RequestTarget, strings.ToLower(r.Method), r.URL.RequestURI()))
+			continue
+		case Created, Expires:
+			value := s.created
+			if h == Expires {
+				value = s.expires
+			}
+			if len(value) == 0 {
+				return "", fmt.Errorf("%w: %s", ErrMissingSignedHeader, h)
+			}
+			lines = append(lines, h+": "+value)
+			continue
+		}
+		var values []string
+		for _, v := range r.Header.Values(h) {
+			values = append(values, strings.TrimSpace(v))
+		}
+		if h == "host" && len(values) == 0 && len(r.Host) > 0 {
+			values = []string{r.Host}
+		}
+		if len(values) == 0 {
+			return "", fmt.Errorf("%w: %s", ErrMissingSignedHeader, h)
+		}
+		lines = append(lines, h+": "+strings.Join(values, ", "))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// VerifyDigest checks a Digest Header, such as "SHA-256=<base64>", against body; every
+// supported digest listed in the header must match
+func VerifyDigest(header string, body []byte) error {
+	if len(header) == 0 {
+		return ErrMissingDigestHeader
+	}
+
+	var checked bool
+	for _, part := range strings.Split(header, ",") {
+		eq := strings.IndexByte(part, '=')
+		if eq < 0 {
+			return ErrDigestVerificationFailed
+		}
+		var h hash.Hash
+		switch strings.ToUpper(strings.TrimSpace(part[:eq])) {
+		case "SHA-256":
+			h = sha256.New()
+		case "SHA-512":
+			h = sha512.New()
+		default:
+			continue
+		}
+		expected, err := base64.StdEncoding.DecodeString(strings.TrimSpace(part[eq+1:]))
+		if err != nil {
+			return ErrDigestVerificationFailed
+		}
+		_, _ = h.Write(body)
+		if !hmac.Equal(expected, h.Sum(nil)) {
+			return ErrDigestVerificationFailed
+		}
+		checked = true
+	}
+	if !checked {
+		return ErrUnsupportedDigest
+	}
+	return nil
+}
+
+func verify(algorithm string, key interface{}, signed, signature []byte) error {
+	switch algorithm {
+	case AlgorithmHmacSha256:
+		secret, ok := key.([]byte)
+		if !ok {
+			return ErrInvalidKey
+		}
+		mac := hmac.New(sha256.New, secret)
+		_, _ = mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return ErrSignatureVerificationFailed
+		}
+	case AlgorithmEd25519:
+		public, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return ErrInvalidKey
+		}
+		if !ed25519.Verify(public, signed, signature) {
+			return ErrSignatureVerificationFailed
+		}
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+	return nil
+}
+
+func signatureHeader(h http.Header) string {
+	if sig := h.Get("Signature"); len(sig) > 0 {
+		return sig
+	}
+	if auth := h.Get("Authorization"); strings.HasPrefix(auth, "Signature ") {
+		return auth
+	}
+	return ""
+}
+
+func (s Signature) covers(header string) bool {
+	header = strings.ToLower(header)
+	for _, h := range s.Headers {
+		if h == header {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,430 @@
+package httpsig
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	path   = "/webhooks"
+	secret = "sampleSecret!"
+)
+
+var body = []byte(`{"event":"build","action":"created"}`)
+
+func newRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path+"?source=test", bytes.NewReader(body))
+	r.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	return r
+}
+
+// signExpiring signs r with the hmac key, covering (created) and (expires) sent unquoted
+func signExpiring(r *http.Request, expires time.Time) error {
+	params := fmt.Sprintf(`keyId="hmac-key",algorithm="hmac-sha256",created=%d,expires=%d,headers="(request-target) (created) (expires) date"`,
+		time.Now().Unix(), expires.Unix())
+	sig, err := ParseSignature(params + `,signature="c2lnbmF0dXJl"`)
+	if err != nil {
+		return err
+	}
+	signed, err := sig.SigningString(r)
+	if err != nil {
+		return err
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	r.Header.Set("Signature", params+`,signature="`+base64.StdEncoding.EncodeToString(mac.Sum(nil))+`"`)
+	return nil
+}
+
+func TestVerify(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPublic, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	keys := func(keyID string) (interface{}, error) {
+		switch keyID {
+		case "hmac-key":
+			return []byte(secret), nil
+		case "ed25519-key":
+			return public, nil
+		case "other-key":
+			return otherPublic, nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	date := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	clock := func(now time.Time) func() time.Time {
+		return func() time.Time { return now }
+	}
+
+	tests := []struct {
+		name     string
+		verifier Verifier
+		prepare  func(r *http.Request) error
+		err      error
+	}{
+		{
+			name:     "HmacSha256",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+		},
+		{
+			name:     "Ed25519",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "ed25519-key", AlgorithmEd25519, private)
+			},
+		},
+		{
+			name:     "AuthorizationHeader",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				if err := Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret)); err != nil {
+					return err
+				}
+				r.Header.Set("Authorization", "Signature "+r.Header.Get("Signature"))
+				r.Header.Del("Signature")
+				return nil
+			},
+		},
+		{
+			name:     "CustomRequiredHeaders",
+			verifier: Verifier{Keys: keys, Required: []string{"date", "digest"}},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret), "date", "digest")
+			},
+		},
+		{
+			name:     "MissingSignature",
+			verifier: Verifier{Keys: keys},
+			prepare:  func(r *http.Request) error { return nil },
+			err:      ErrMissingSignatureHeader,
+		},
+		{
+			name:     "MalformedSignature",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Signature", `keyId="hmac-key",algorithm=hmac-sha256`)
+				return nil
+			},
+			err: ErrMalformedSignatureHeader,
+		},
+		{
+			name:     "RequiredHeaderNotSigned",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret), "date", "digest")
+			},
+			err: ErrRequiredHeaderNotSigned,
+		},
+		{
+			name:     "UnknownKey",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "missing-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrUnknownKey,
+		},
+		{
+			name:     "NoKeys",
+			verifier: Verifier{},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrUnknownKey,
+		},
+		{
+			name:     "WrongSecret",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte("badSecret"))
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+		{
+			name:     "WrongPublicKey",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "other-key", AlgorithmEd25519, private)
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+		{
+			name:     "KeyTypeMismatch",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "ed25519-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrInvalidKey,
+		},
+		{
+			name:     "UnsupportedAlgorithm",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Digest", "SHA-256=")
+				r.Header.Set("Signature", `keyId="hmac-key",algorithm="rsa-sha1",headers="(request-target) date digest",signature="c2lnbmF0dXJl"`)
+				return nil
+			},
+			err: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:     "TamperedTarget",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				if err := Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret)); err != nil {
+					return err
+				}
+				r.URL.RawQuery = "source=other"
+				return nil
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+		{
+			name:     "TamperedBody",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				return Sign(r, []byte(`{"event":"repo"}`), "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrDigestVerificationFailed,
+		},
+		{
+			name:     "MissingSignedHeader",
+			verifier: Verifier{Keys: keys},
+			prepare: func(r *http.Request) error {
+				if err := Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret)); err != nil {
+					return err
+				}
+				r.Header.Del("Date")
+				return nil
+			},
+			err: ErrMissingSignedHeader,
+		},
+		{
+			name:     "Created",
+			verifier: Verifier{Keys: keys, Required: []string{RequestTarget, Created}},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret), RequestTarget, Created, "digest")
+			},
+		},
+		{
+			name:     "NotExpired",
+			verifier: Verifier{Keys: keys, Required: []string{Expires}},
+			prepare: func(r *http.Request) error {
+				return signExpiring(r, time.Now().Add(time.Minute))
+			},
+		},
+		{
+			name:     "Expired",
+			verifier: Verifier{Keys: keys, Required: []string{Expires}},
+			prepare: func(r *http.Request) error {
+				return signExpiring(r, time.Now().Add(-time.Minute))
+			},
+			err: ErrSignatureExpired,
+		},
+		{
+			name:     "ExpiresTampered",
+			verifier: Verifier{Keys: keys, Required: []string{Expires}},
+			prepare: func(r *http.Request) error {
+				if err := signExpiring(r, time.Now().Add(-time.Minute)); err != nil {
+					return err
+				}
+				sig := r.Header.Get("Signature")
+				expires := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+				r.Header.Set("Signature", strings.Replace(sig, "expires="+expires, "expires="+strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10), 1))
+				return nil
+			},
+			err: ErrSignatureVerificationFailed,
+		},
+		{
+			name:     "DateWithinSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute},
+			prepare: func(r *http.Request) error {
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+		},
+		{
+			name:     "DateOutsideSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrDateVerificationFailed,
+		},
+		{
+			name:     "ClockWithinSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute, Now: clock(date.Add(time.Minute))},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", date.Format(http.TimeFormat))
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+		},
+		{
+			name:     "ClockBeyondSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute, Now: clock(date.Add(time.Minute + time.Second))},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", date.Format(http.TimeFormat))
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrDateVerificationFailed,
+		},
+		{
+			name:     "ClockAheadWithinSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute, Now: clock(date.Add(-time.Minute))},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", date.Format(http.TimeFormat))
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+		},
+		{
+			name:     "ClockAheadBeyondSkew",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute, Now: clock(date.Add(-time.Minute - time.Second))},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", date.Format(http.TimeFormat))
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrDateVerificationFailed,
+		},
+		{
+			name:     "ClockBeforeExpires",
+			verifier: Verifier{Keys: keys, Required: []string{Expires}, Now: clock(time.Now().Add(2 * time.Minute))},
+			prepare: func(r *http.Request) error {
+				return signExpiring(r, time.Now().Add(time.Hour))
+			},
+		},
+		{
+			name:     "ClockAfterExpires",
+			verifier: Verifier{Keys: keys, Required: []string{Expires}, Now: clock(time.Now().Add(2 * time.Hour))},
+			prepare: func(r *http.Request) error {
+				return signExpiring(r, time.Now().Add(time.Hour))
+			},
+			err: ErrSignatureExpired,
+		},
+		{
+			name:     "InvalidDate",
+			verifier: Verifier{Keys: keys, MaxSkew: time.Minute},
+			prepare: func(r *http.Request) error {
+				r.Header.Set("Date", "yesterday")
+				return Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret))
+			},
+			err: ErrInvalidDateHeader,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newRequest()
+			require.NoError(t, tc.prepare(r))
+
+			err := tc.verifier.Verify(r, body)
+			if tc.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tc.err), "expected %v, got %v", tc.err, err)
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	assert := require.New(t)
+
+	sig, err := ParseSignature(`Signature keyId="hmac-key", algorithm="HMAC-SHA256", headers="(request-target) Date Digest", signature="c2lnbmF0dXJl"`)
+	assert.NoError(err)
+	assert.Equal("hmac-key", sig.KeyID)
+	assert.Equal(AlgorithmHmacSha256, sig.Algorithm)
+	assert.Equal([]string{RequestTarget, "date", "digest"}, sig.Headers)
+	assert.Equal([]byte("signature"), sig.Signature)
+
+	sig, err = ParseSignature(`keyId="hmac-key",signature="c2lnbmF0dXJl"`)
+	assert.NoError(err)
+	assert.Equal([]string{"date"}, sig.Headers)
+
+	sig, err = ParseSignature(`keyId="hmac-key",created=1402170695,expires=1402170995.5,headers="(created) (expires)",signature="c2lnbmF0dXJl"`)
+	assert.NoError(err)
+	assert.Equal(time.Unix(1402170695, 0), sig.Created)
+	assert.Equal(time.Unix(1402170995, int64(time.Second/2)), sig.Expires)
+	signed, err := sig.SigningString(newRequest())
+	assert.NoError(err)
+	assert.Equal("(created): 1402170695\n(expires): 1402170995.5", signed)
+
+	sig, err = ParseSignature(`keyId="hmac-key",created="1402170695",signature="c2lnbmF0dXJl"`)
+	assert.NoError(err)
+	assert.Equal(time.Unix(1402170695, 0), sig.Created)
+
+	_, err = SigningString(newRequest(), []string{Created})
+	assert.True(errors.Is(err, ErrMissingSignedHeader))
+
+	_, err = ParseSignature(`keyId="hmac-key",created=yesterday,signature="c2lnbmF0dXJl"`)
+	assert.Equal(ErrMalformedSignatureHeader, err)
+
+	_, err = ParseSignature(`keyId=hmac-key,signature="c2lnbmF0dXJl"`)
+	assert.Equal(ErrMalformedSignatureHeader, err)
+
+	_, err = ParseSignature(`keyId="hmac-key",signature="not base64!"`)
+	assert.Equal(ErrMalformedSignatureHeader, err)
+
+	_, err = ParseSignature(`keyId="hmac-key"`)
+	assert.Equal(ErrMalformedSignatureHeader, err)
+}
+
+func TestVerifyDigest(t *testing.T) {
+	sum := sha512.Sum512(body)
+	sha512Digest := "SHA-512=" + base64.StdEncoding.EncodeToString(sum[:])
+
+	r := newRequest()
+	require.NoError(t, Sign(r, body, "hmac-key", AlgorithmHmacSha256, []byte(secret)))
+	sha256Digest := r.Header.Get("Digest")
+
+	tests := []struct {
+		name   string
+		header string
+		err    error
+	}{
+		{name: "SHA-256", header: sha256Digest},
+		{name: "SHA-512", header: sha512Digest},
+		{name: "Multiple", header: sha256Digest + ", " + sha512Digest},
+		{name: "UnknownAlgorithmSkipped", header: "MD5=Q2hlY2sgSW50ZWdyaXR5IQ==," + sha256Digest},
+		{name: "Missing", err: ErrMissingDigestHeader},
+		{name: "Unsupported", header: "MD5=Q2hlY2sgSW50ZWdyaXR5IQ==", err: ErrUnsupportedDigest},
+		{name: "Mismatch", header: "SHA-256=" + base64.StdEncoding.EncodeToString(make([]byte, 32)), err: ErrDigestVerificationFailed},
+		{name: "OneMismatch", header: sha512Digest + ",SHA-256=" + base64.StdEncoding.EncodeToString(make([]byte, 32)), err: ErrDigestVerificationFailed},
+		{name: "Malformed", header: "SHA-256", err: ErrDigestVerificationFailed},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.err, VerifyDigest(tt.header, body), tt.name)
+	}
+}
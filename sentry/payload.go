@@ -0,0 +1,243 @@
+package sentry
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Actor is the user or application which triggered the webhook
+type Actor struct {
+	Type string      `json:"type"`
+	ID   interface{} `json:"id"`
+	Name string      `json:"name"`
+}
+
+// Installation identifies the integration installation the webhook was sent for
+type Installation struct {
+	UUID string `json:"uuid"`
+}
+
+// InstallationPayload contains the information for Sentry's installation hook resource
+type InstallationPayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		Installation struct {
+			App struct {
+				UUID string `json:"uuid"`
+				Slug string `json:"slug"`
+			} `json:"app"`
+			Organization struct {
+				Slug string `json:"slug"`
+			} `json:"organization"`
+			UUID   string `json:"uuid"`
+			Status string `json:"status"`
+			Code   string `json:"code"`
+		} `json:"installation"`
+	} `json:"data"`
+}
+
+// Project contains Sentry's project information
+type Project struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Platform string `json:"platform"`
+}
+
+// Issue contains Sentry's issue (group) information
+type Issue struct {
+	ID                  string                 `json:"id"`
+	ShortID             string                 `json:"shortId"`
+	Title               string                 `json:"title"`
+	Culprit             string                 `json:"culprit"`
+	Permalink           *string                `json:"permalink"`
+	Logger              *string                `json:"logger"`
+	Level               string                 `json:"level"`
+	Status              string                 `json:"status"`
+	Substatus           string                 `json:"substatus"`
+	StatusDetails       map[string]interface{} `json:"statusDetails"`
+	IsPublic            bool                   `json:"isPublic"`
+	Platform            string                 `json:"platform"`
+	Project             Project                `json:"project"`
+	Type                string                 `json:"type"`
+	Metadata            map[string]interface{} `json:"metadata"`
+	NumComments         int64                  `json:"numComments"`
+	AssignedTo          *Assignee              `json:"assignedTo"`
+	IsBookmarked        bool                   `json:"isBookmarked"`
+	IsSubscribed        bool                   `json:"isSubscribed"`
+	SubscriptionDetails *struct {
+		Reason string `json:"reason"`
+	} `json:"subscriptionDetails"`
+	HasSeen       bool      `json:"hasSeen"`
+	Annotations   []string  `json:"annotations"`
+	IssueType     string    `json:"issueType"`
+	IssueCategory string    `json:"issueCategory"`
+	Priority      string    `json:"priority"`
+	Count         string    `json:"count"`
+	UserCount     int64     `json:"userCount"`
+	FirstSeen     time.Time `json:"firstSeen"`
+	LastSeen      time.Time `json:"lastSeen"`
+	URL           string    `json:"url"`
+	WebURL        string    `json:"web_url"`
+	ProjectURL    string    `json:"project_url"`
+}
+
+// Assignee contains the user or team an issue is assigned to
+type Assignee struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// IssuePayload contains the information for Sentry's issue hook resource
+type IssuePayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		Issue Issue `json:"issue"`
+	} `json:"data"`
+}
+
+// EventData contains a Sentry error event
+type EventData struct {
+	EventID     string                 `json:"event_id"`
+	Project     int64                  `json:"project"`
+	Release     *string                `json:"release"`
+	Dist        *string                `json:"dist"`
+	Platform    string                 `json:"platform"`
+	Message     string                 `json:"message"`
+	Datetime    time.Time              `json:"datetime"`
+	Timestamp   float64                `json:"timestamp"`
+	Received    float64                `json:"received"`
+	Level       string                 `json:"level"`
+	Logger      string                 `json:"logger"`
+	Culprit     string                 `json:"culprit"`
+	Location    string                 `json:"location"`
+	Title       string                 `json:"title"`
+	Type        string                 `json:"type"`
+	Environment string                 `json:"environment"`
+	Fingerprint []string               `json:"fingerprint"`
+	Tags        [][]string             `json:"tags"`
+	User        map[string]interface{} `json:"user"`
+	SDK         struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"sdk"`
+	Contexts  map[string]interface{} `json:"contexts"`
+	Extra     map[string]interface{} `json:"extra"`
+	Request   map[string]interface{} `json:"request"`
+	Exception *struct {
+		Values []struct {
+			Type       string          `json:"type"`
+			Value      string          `json:"value"`
+			Module     *string         `json:"module"`
+			Stacktrace json.RawMessage `json:"stacktrace"`
+			Mechanism  json.RawMessage `json:"mechanism"`
+		} `json:"values"`
+	} `json:"exception"`
+	Metadata map[string]interface{} `json:"metadata"`
+	GroupID  int64                  `json:"group_id"`
+	IssueID  string                 `json:"issue_id"`
+	URL      string                 `json:"url"`
+	WebURL   string                 `json:"web_url"`
+	IssueURL string                 `json:"issue_url"`
+}
+
+// EventAlertPayload contains the information for Sentry's event_alert hook resource
+type EventAlertPayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		Event         EventData `json:"event"`
+		TriggeredRule string    `json:"triggered_rule"`
+		IssueAlert    *struct {
+			Title    string `json:"title"`
+			Settings []struct {
+				Name  string      `json:"name"`
+				Value interface{} `json:"value"`
+			} `json:"settings"`
+		} `json:"issue_alert"`
+	} `json:"data"`
+}
+
+// ErrorPayload contains the information for Sentry's error hook resource
+type ErrorPayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		Error EventData `json:"error"`
+	} `json:"data"`
+}
+
+// MetricAlertPayload contains the information for Sentry's metric_alert hook resource
+type MetricAlertPayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		DescriptionText  string `json:"description_text"`
+		DescriptionTitle string `json:"description_title"`
+		WebURL           string `json:"web_url"`
+		MetricAlert      struct {
+			ID             string     `json:"id"`
+			Identifier     string     `json:"identifier"`
+			OrganizationID string     `json:"organization_id"`
+			Status         int64      `json:"status"`
+			StatusMethod   int64      `json:"status_method"`
+			Type           int64      `json:"type"`
+			Title          string     `json:"title"`
+			DateStarted    time.Time  `json:"date_started"`
+			DateDetected   time.Time  `json:"date_detected"`
+			DateCreated    time.Time  `json:"date_created"`
+			DateClosed     *time.Time `json:"date_closed"`
+			AlertRule      struct {
+				ID                 string    `json:"id"`
+				Name               string    `json:"name"`
+				Status             int64     `json:"status"`
+				Environment        *string   `json:"environment"`
+				Projects           []string  `json:"projects"`
+				Dataset            string    `json:"dataset"`
+				Query              string    `json:"query"`
+				Aggregate          string    `json:"aggregate"`
+				TimeWindow         float64   `json:"time_window"`
+				Resolution         float64   `json:"resolution"`
+				ThresholdType      int64     `json:"threshold_type"`
+				ResolveThreshold   *float64  `json:"resolve_threshold"`
+				IncludeAllProjects bool      `json:"include_all_projects"`
+				OrganizationID     string    `json:"organization_id"`
+				DateCreated        time.Time `json:"date_created"`
+				DateModified       time.Time `json:"date_modified"`
+				Triggers           []struct {
+					ID               string            `json:"id"`
+					AlertRuleID      string            `json:"alert_rule_id"`
+					Label            string            `json:"label"`
+					ThresholdType    int64             `json:"threshold_type"`
+					AlertThreshold   float64           `json:"alert_threshold"`
+					ResolveThreshold *float64          `json:"resolve_threshold"`
+					DateCreated      time.Time         `json:"date_created"`
+					Actions          []json.RawMessage `json:"actions"`
+				} `json:"triggers"`
+			} `json:"alert_rule"`
+		} `json:"metric_alert"`
+	} `json:"data"`
+}
+
+// CommentPayload contains the information for Sentry's comment hook resource
+type CommentPayload struct {
+	Action       string       `json:"action"`
+	Actor        Actor        `json:"actor"`
+	Installation Installation `json:"installation"`
+	Data         struct {
+		CommentID   string    `json:"comment_id"`
+		IssueID     int64     `json:"issue_id"`
+		ProjectSlug string    `json:"project_slug"`
+		Timestamp   time.Time `json:"timestamp"`
+		Comment     string    `json:"comment"`
+	} `json:"data"`
+}
@@ -0,0 +1,235 @@
+package sentry
+
+// this package receives Sentry integration platform webhooks
+// https://docs.sentry.io/organization/integrations/integration-platform/webhooks/
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parse errors
+var (
+	ErrEventNotSpecifiedToParse    = errors.New("no Event specified to parse")
+	ErrInvalidHTTPMethod           = errors.New("invalid HTTP Method")
+	ErrMissingHookResourceHeader   = errors.New("missing Sentry-Hook-Resource Header")
+	ErrMissingHookSignatureHeader  = errors.New("missing Sentry-Hook-Signature Header")
+	ErrMissingHookTimestampHeader  = errors.New("missing Sentry-Hook-Timestamp Header")
+	ErrInvalidHookTimestampHeader  = errors.New("invalid Sentry-Hook-Timestamp Header")
+	ErrTimestampVerificationFailed = errors.New("timestamp outside of the allowed tolerance")
+	ErrEventNotFound               = errors.New("event not defined to be parsed")
+	ErrParsingPayload              = errors.New("error parsing payload")
+	ErrHMACVerificationFailed      = errors.New("HMAC verification failed")
+)
+
+// DefaultTolerance is the maximum age of a delivery, based on its Sentry-Hook-Timestamp Header,
+// accepted when a secret is registered and no other tolerance is configured
+const DefaultTolerance = 5 * time.Minute
+
+// Event defines a Sentry hook resource type by the Sentry-Hook-Resource Header
+type Event string
+
+// Sentry hook types
+const (
+	InstallationEvent Event = "installation"
+	EventAlertEvent   Event = "event_alert"
+	IssueEvent        Event = "issue"
+	MetricAlertEvent  Event = "metric_alert"
+	ErrorEvent        Event = "error"
+	CommentEvent      Event = "comment"
+)
+
+// Sentry hook actions
+const (
+	InstallationCreatedAction = "created"
+	InstallationDeletedAction = "deleted"
+
+	EventAlertTriggeredAction = "triggered"
+
+	IssueCreatedAction    = "created"
+	IssueResolvedAction   = "resolved"
+	IssueAssignedAction   = "assigned"
+	IssueArchivedAction   = "archived"
+	IssueUnresolvedAction = "unresolved"
+
+	MetricAlertCriticalAction = "critical"
+	MetricAlertWarningAction  = "warning"
+	MetricAlertResolvedAction = "resolved"
+
+	ErrorCreatedAction = "created"
+
+	CommentCreatedAction = "created"
+	CommentUpdatedAction = "updated"
+	CommentDeletedAction = "deleted"
+)
+
+// Option is a configuration option for the webhook
+type Option func(*Webhook) error
+
+// Options is a namespace var for configuration options
+var Options = WebhookOptions{}
+
+// WebhookOptions is a namespace for configuration option methods
+type WebhookOptions struct{}
+
+// Secret registers the Sentry integration client secret
+func (WebhookOptions) Secret(secret string) Option {
+	return func(hook *Webhook) error {
+		hook.secret = secret
+		return nil
+	}
+}
+
+// Tolerance sets the maximum accepted difference between the Sentry-Hook-Timestamp Header
+// and the current time; a zero or negative tolerance disables the timestamp check
+func (WebhookOptions) Tolerance(tolerance time.Duration) Option {
+	return func(hook *Webhook) error {
+		hook.tolerance = tolerance
+		return nil
+	}
+}
+
+// Webhook instance contains all methods needed to process events
+type Webhook struct {
+	secret    string
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// New creates and returns a WebHook instance denoted by the Provider type
+func New(options ...Option) (*Webhook, error) {
+	hook := &Webhook{
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+	for _, opt := range options {
+		if err := opt(hook); err != nil {
+			return nil, errors.New("Error applying Option")
+		}
+	}
+	return hook, nil
+}
+
+// Parse verifies and parses the events specified and returns the payload object or an error
+func (hook Webhook) Parse(r *http.Request, events ...Event) (interface{}, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+
+	if len(events) == 0 {
+		return nil, ErrEventNotSpecifiedToParse
+	}
+	if r.Method != http.MethodPost {
+		return nil, ErrInvalidHTTPMethod
+	}
+
+	event := r.Header.Get("Sentry-Hook-Resource")
+	if len(event) == 0 {
+		return nil, ErrMissingHookResourceHeader
+	}
+
+	sentryEvent := Event(event)
+
+	var found bool
+	for _, evt := range events {
+		if evt == sentryEvent {
+			found = true
+			break
+		}
+	}
+	// event not defined to be parsed
+	if !found {
+		return nil, ErrEventNotFound
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, ErrParsingPayload
+	}
+
+	// If we have a Secret set, we should check the timestamp and the MAC
+	if len(hook.secret) > 0 {
+		if err := hook.verifyTimestamp(r.Header.Get("Sentry-Hook-Timestamp")); err != nil {
+			return nil, err
+		}
+
+		signature := r.Header.Get("Sentry-Hook-Signature")
+		if len(signature) == 0 {
+			return nil, ErrMissingHookSignatureHeader
+		}
+
+		mac := hmac.New(sha256.New, []byte(hook.secret))
+		_, _ = mac.Write(payload)
+		expectedMAC := hex.EncodeToString(mac.Sum(nil))
+
+		if !hmac.Equal([]byte(signature), []byte(expectedMAC)) {
+			return nil, ErrHMACVerificationFailed
+		}
+	}
+
+	switch sentryEvent {
+	case InstallationEvent:
+		var pl InstallationPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case EventAlertEvent:
+		var pl EventAlertPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case IssueEvent:
+		var pl IssuePayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case MetricAlertEvent:
+		var pl MetricAlertPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case ErrorEvent:
+		var pl ErrorPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	case CommentEvent:
+		var pl CommentPayload
+		err = json.Unmarshal([]byte(payload), &pl)
+		return pl, err
+	default:
+		return nil, fmt.Errorf("unknown event %s", sentryEvent)
+	}
+}
+
+// verifyTimestamp checks the Sentry-Hook-Timestamp Header, expressed in seconds since the epoch,
+// against the configured tolerance
+func (hook Webhook) verifyTimestamp(header string) error {
+	if len(header) == 0 {
+		return ErrMissingHookTimestampHeader
+	}
+
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(header), 64)
+	if err != nil {
+		return ErrInvalidHookTimestampHeader
+	}
+
+	if hook.tolerance <= 0 {
+		return nil
+	}
+
+	sent := time.Unix(0, int64(seconds*float64(time.Second)))
+	diff := hook.now().Sub(sent)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > hook.tolerance {
+		return ErrTimestampVerificationFailed
+	}
+	return nil
+}
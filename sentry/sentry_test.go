@@ -0,0 +1,284 @@
+package sentry
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// NOTES:
+// - Run "go test" to run tests
+// - Run "gocov test | gocov report" to report on test converage by file
+// - Run "gocov test | gocov annotate -" to report on all code and functions, those ,marked with "MISS" were never called
+//
+// or
+//
+// -- may be a good idea to change to output path to somewherelike /tmp
+// go test -coverprofile cover.out && go tool cover -html=cover.out -o cover.html
+//
+
+const (
+	path = "/webhooks"
+)
+
+var hook *Webhook
+
+func TestMain(m *testing.M) {
+	// setup
+	var err error
+	hook, err = New(Options.Secret("IsWishesWereHorsesWedAllBeEatingSteak!"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+	// teardown
+}
+
+func newServer(handler http.HandlerFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, handler)
+	return httptest.NewServer(mux)
+}
+
+func sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(hook.secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestBadRequests(t *testing.T) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	tests := []struct {
+		name    string
+		event   Event
+		payload io.Reader
+		headers http.Header
+		err     error
+	}{
+		{
+			name:    "BadNoEventHeader",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{},
+			err:     ErrMissingHookResourceHeader,
+		},
+		{
+			name:    "UnsubscribedEvent",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"noneexistant_event"},
+			},
+			err: ErrEventNotFound,
+		},
+		{
+			name:    "BadBody",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Timestamp": []string{now},
+				"Sentry-Hook-Signature": []string{sign([]byte(""))},
+			},
+			err: ErrParsingPayload,
+		},
+		{
+			name:    "MissingTimestamp",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Signature": []string{sign([]byte("{}"))},
+			},
+			err: ErrMissingHookTimestampHeader,
+		},
+		{
+			name:    "BadTimestamp",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Timestamp": []string{"yesterday"},
+				"Sentry-Hook-Signature": []string{sign([]byte("{}"))},
+			},
+			err: ErrInvalidHookTimestampHeader,
+		},
+		{
+			name:    "ExpiredTimestamp",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Timestamp": []string{strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)},
+				"Sentry-Hook-Signature": []string{sign([]byte("{}"))},
+			},
+			err: ErrTimestampVerificationFailed,
+		},
+		{
+			name:    "BadSignatureLength",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Timestamp": []string{now},
+				"Sentry-Hook-Signature": []string{""},
+			},
+			err: ErrMissingHookSignatureHeader,
+		},
+		{
+			name:    "BadSignatureMatch",
+			event:   IssueEvent,
+			payload: bytes.NewBuffer([]byte("{}")),
+			headers: http.Header{
+				"Sentry-Hook-Resource":  []string{"issue"},
+				"Sentry-Hook-Timestamp": []string{now},
+				"Sentry-Hook-Signature": []string{"111"},
+			},
+			err: ErrHMACVerificationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			var parseError error
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				_, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, tc.payload)
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.Equal(tc.err, parseError)
+		})
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    Event
+		typ      interface{}
+		filename string
+		headers  http.Header
+	}{
+		{
+			name:     "InstallationEvent",
+			event:    InstallationEvent,
+			typ:      InstallationPayload{},
+			filename: "../testdata/sentry/installation.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"installation"},
+			},
+		},
+		{
+			name:     "EventAlertEvent",
+			event:    EventAlertEvent,
+			typ:      EventAlertPayload{},
+			filename: "../testdata/sentry/event-alert.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"event_alert"},
+			},
+		},
+		{
+			name:     "IssueEvent",
+			event:    IssueEvent,
+			typ:      IssuePayload{},
+			filename: "../testdata/sentry/issue.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"issue"},
+			},
+		},
+		{
+			name:     "MetricAlertEvent",
+			event:    MetricAlertEvent,
+			typ:      MetricAlertPayload{},
+			filename: "../testdata/sentry/metric-alert.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"metric_alert"},
+			},
+		},
+		{
+			name:     "ErrorEvent",
+			event:    ErrorEvent,
+			typ:      ErrorPayload{},
+			filename: "../testdata/sentry/error.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"error"},
+			},
+		},
+		{
+			name:     "CommentEvent",
+			event:    CommentEvent,
+			typ:      CommentPayload{},
+			filename: "../testdata/sentry/comment.json",
+			headers: http.Header{
+				"Sentry-Hook-Resource": []string{"comment"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tc := tt
+		client := &http.Client{}
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := require.New(t)
+			payload, err := os.ReadFile(tc.filename)
+			assert.NoError(err)
+
+			var parseError error
+			var results interface{}
+			server := newServer(func(w http.ResponseWriter, r *http.Request) {
+				results, parseError = hook.Parse(r, tc.event)
+			})
+			defer server.Close()
+			req, err := http.NewRequest(http.MethodPost, server.URL+path, bytes.NewReader(payload))
+			assert.NoError(err)
+			req.Header = tc.headers
+			req.Header.Set("Sentry-Hook-Timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+			req.Header.Set("Sentry-Hook-Signature", sign(payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := client.Do(req)
+			assert.NoError(err)
+			assert.Equal(http.StatusOK, resp.StatusCode)
+			assert.NoError(parseError)
+			assert.Equal(reflect.TypeOf(tc.typ), reflect.TypeOf(results))
+		})
+	}
+}
+
+func TestTolerance(t *testing.T) {
+	assert := require.New(t)
+	h, err := New(Options.Secret("secret"), Options.Tolerance(0))
+	assert.NoError(err)
+	assert.NoError(h.verifyTimestamp("946684800"))
+
+	h, err = New(Options.Secret("secret"), Options.Tolerance(time.Minute))
+	assert.NoError(err)
+	h.now = func() time.Time { return time.Unix(946684800, 0) }
+	assert.NoError(h.verifyTimestamp("946684830"))
+	assert.NoError(h.verifyTimestamp("946684770.5"))
+	assert.Equal(ErrTimestampVerificationFailed, h.verifyTimestamp("946684900"))
+}